@@ -0,0 +1,109 @@
+package uuid
+
+// Topic names what an Advice is about
+type Topic string
+
+const (
+	TopicMAC       Topic = "mac"       // the node field is a real MAC address
+	TopicTime      Topic = "time"      // the creation time can be read back
+	TopicUID       Topic = "uid"       // v2 carries a local user or group ID
+	TopicMD5       Topic = "md5"       // v3 is an MD5 hash
+	TopicSHA1      Topic = "sha1"      // v5 is a SHA-1 hash
+	TopicOrdering  Topic = "ordering"  // the UUID does not sort by creation time
+	TopicSentinel  Topic = "sentinel"  // Nil or Max used where an ID is expected
+	TopicVariant   Topic = "variant"   // not the RFC4122 variant
+	TopicVersion   Topic = "version"   // a version no RFC defines
+	TopicByteOrder Topic = "byteorder" // possibly a Microsoft GUID read in the wrong byte order
+)
+
+// Severity says how much an Advice matters
+type Severity int
+
+const (
+	SeverityInfo    Severity = iota // worth knowing
+	SeverityWarning                 // worth fixing
+)
+
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "info"
+}
+
+// Advice is one suggestion from Advise. Suggest is the version to move to, 0 when there is none
+type Advice struct {
+	Topic    Topic    `json:"topic"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	Suggest  Version  `json:"suggest,omitempty"`
+}
+
+// Advise inspects u for risky identifier practices, for audit tooling scanning stored IDs.
+// It only looks at the bits, so it cannot tell how an ID is used; no advice means nothing stood out
+func Advise(u UUID) []Advice {
+
+	var a []Advice
+
+	add := func(topic Topic, s Severity, suggest Version, msg string) {
+		a = append(a, Advice{Topic: topic, Severity: s, Message: msg, Suggest: suggest})
+	}
+
+	if u == Nil || u == Max {
+		add(TopicSentinel, SeverityWarning, 0, "Nil and Max are sentinel values, not identifiers")
+		return a
+	}
+
+	switch u.Variant() {
+	case VariantRFC4122:
+	case VariantMicrosoft:
+		add(TopicVariant, SeverityInfo, 0, "Microsoft variant, likely an old COM GUID")
+		return a
+	default:
+		add(TopicVariant, SeverityWarning, 4, "not an RFC4122 variant UUID, it may be random bytes or an ID from another system")
+		return a
+	}
+
+	// hardware node IDs have the multicast bit clear, the random ones RFC4122 Section 4.5 describes set it
+	mac := u[10]&0x01 == 0
+
+	switch u.Version() {
+	case 1:
+		if mac {
+			add(TopicMAC, SeverityWarning, 7, "v1 exposes the MAC address of the host that made it; consider v7 (or v6 to keep the v1 layout)")
+		}
+
+		add(TopicOrdering, SeverityInfo, 6, "v1 stores the low bits of the time first so it does not sort by creation time; v6 and v7 do")
+		add(TopicTime, SeverityInfo, 0, "the creation time can be read back from v1")
+	case 2:
+		add(TopicUID, SeverityWarning, 4, "v2 exposes a local user or group ID and only has about 7 minute time resolution; consider v4 or v7")
+
+		if mac {
+			add(TopicMAC, SeverityWarning, 7, "v2 exposes the MAC address of the host that made it")
+		}
+	case 3:
+		add(TopicMD5, SeverityWarning, 8, "v3 uses MD5; consider v5, or rehashing the name with SHA-256 into a v8 (FromSHA256Digest)")
+	case 5:
+		add(TopicSHA1, SeverityInfo, 8, "v5 uses SHA-1, which is fine for name based IDs but not where the name must stay secret; consider a SHA-256 v8 (FromSHA256Digest)")
+	case 6:
+		if mac {
+			add(TopicMAC, SeverityWarning, 7, "v6 exposes the MAC address of the host that made it; consider v7 or a random node ID")
+		}
+
+		add(TopicTime, SeverityInfo, 0, "the creation time can be read back from v6")
+	case 7:
+		add(TopicTime, SeverityInfo, 0, "the creation time (to the millisecond) can be read back from v7; use v4 where it must not leak")
+	case 4, 8:
+	default:
+		add(TopicVersion, SeverityWarning, 4, "no RFC defines this version")
+
+		var swapped UUID
+		swapGUID(swapped[:], u[:])
+
+		if knownVersion(swapped) {
+			add(TopicByteOrder, SeverityInfo, 0, "with the first three fields byte swapped the version is valid, it may be a GUID stored little endian")
+		}
+	}
+
+	return a
+}
@@ -0,0 +1,53 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestAdvise(t *testing.T) {
+
+	v3, _ := NewV3(NamespaceDNS, "name")
+	v5, _ := NewV5(NamespaceDNS, "name")
+	hw := NewGenerator(WithNodeID([6]byte{0x00, 0x1b, 0x63, 0x84, 0x45, 0xe6}))
+	hwV1, _ := hw.NewV1()
+	randV1, _ := NewGenerator(WithNodeID(randomNode())).NewV1()
+
+	// 6ba7b810-9dad-41d1-80b4-00c04fd430c8 (a v4) stored little endian
+	le := Must(ParseLenient("10b8a76b-ad9d-d141-80b4-00c04fd430c8"))
+
+	tests := []struct {
+		uuid   UUID
+		topics []Topic
+	}{
+		{NewV4(), nil},
+		{NewV8([16]byte{}), nil},
+		{hwV1, []Topic{TopicMAC, TopicOrdering, TopicTime}},
+		{randV1, []Topic{TopicOrdering, TopicTime}},
+		{v3, []Topic{TopicMD5}},
+		{v5, []Topic{TopicSHA1}},
+		{NewV7(), []Topic{TopicTime}},
+		{Nil, []Topic{TopicSentinel}},
+		{Max, []Topic{TopicSentinel}},
+		{MustParse("6ba7b810-9dad-11d1-30b4-00c04fd430c8"), []Topic{TopicVariant}},
+		{le, []Topic{TopicVersion, TopicByteOrder}},
+	}
+
+	for _, test := range tests {
+		a := Advise(test.uuid)
+
+		if len(a) != len(test.topics) {
+			t.Error("Advise", test.uuid.String(), "is", a)
+			continue
+		}
+
+		for i := range a {
+			if a[i].Topic != test.topics[i] || a[i].Message == "" {
+				t.Error("Advise", test.uuid.String(), "advice", i, "is", a[i].Topic, "should be:", test.topics[i])
+			}
+		}
+	}
+
+	if a := Advise(v3); a[0].Suggest != 8 || a[0].Severity != SeverityWarning {
+		t.Error("v3 should suggest a v8 as a warning, got:", a)
+	}
+}
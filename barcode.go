@@ -0,0 +1,28 @@
+package uuid
+
+import (
+	"encoding/base32"
+	"strings"
+)
+
+// RFC4648 base32 only uses A-Z and 2-7, which are all in the QR alphanumeric set and
+// Code128 code set B, so the payload encodes densely in both symbologies
+var barcodeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// BarcodePayload returns the UUID as 26 characters of uppercase unpadded base32 for printing
+// as a Code128 or QR barcode
+func (u UUID) BarcodePayload() string {
+	return barcodeEncoding.EncodeToString(u[:])
+}
+
+// FromBarcodePayload decodes a scanned BarcodePayload, in either case
+func FromBarcodePayload(s string) (UUID, error) {
+
+	b, err := barcodeEncoding.DecodeString(strings.ToUpper(strings.TrimSpace(s)))
+
+	if err != nil {
+		return UUID{}, err
+	}
+
+	return FromBytes(b)
+}
@@ -0,0 +1,26 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBarcodePayload(t *testing.T) {
+
+	uuid := NewV4()
+	s := uuid.BarcodePayload()
+
+	if len(s) != 26 || strings.ToUpper(s) != s {
+		t.Fatal("BarcodePayload is not 26 uppercase characters", s)
+	}
+
+	back, err := FromBarcodePayload(strings.ToLower(s))
+
+	if err != nil || back != uuid {
+		t.Error("FromBarcodePayload did not round trip", s, err)
+	}
+
+	if _, err = FromBarcodePayload(s[:20]); err != ErrUUIDSize {
+		t.Error("FromBarcodePayload did not detect short payload", err)
+	}
+}
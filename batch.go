@@ -0,0 +1,61 @@
+package uuid
+
+// NewV4Batch returns n new v4 UUIDs from the default Generator. See Generator.FillV4
+func NewV4Batch(n int) []UUID {
+
+	uuids := make([]UUID, n)
+	defaultGenerator.FillV4(uuids)
+
+	return uuids
+}
+
+// NewV4Batch returns n new v4 UUIDs. See FillV4
+func (g *Generator) NewV4Batch(n int) ([]UUID, error) {
+
+	uuids := make([]UUID, n)
+
+	if err := g.FillV4(uuids); err != nil {
+		return nil, err
+	}
+
+	return uuids, nil
+}
+
+// FillV4 overwrites every UUID in dst with a new v4, for reusing a slice across batches.
+// The random bits are read 4KB (256 UUIDs) at a time, and a source other than crypto/rand is
+// locked once for the whole batch instead of once per UUID. Like NewV4 it honours WithStrictOutput
+// and WithQuota, checking the whole batch before any of it counts
+func (g *Generator) FillV4(dst []UUID) error {
+
+	if err := g.allow(len(dst)); err != nil {
+		return err
+	}
+
+	read := func(b []byte) { readRandom(nil, b) }
+
+	if g.rand != nil || currentConfig().Rand != nil {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		read = g.randomBytes
+	}
+
+	var buf [randPoolSize]byte
+
+	for rest := dst; len(rest) > 0; {
+		n := min(len(rest), len(buf)/uuidSize)
+		read(buf[:n*uuidSize])
+
+		for i := range rest[:n] {
+			copy(rest[i][:], buf[i*uuidSize:])
+			rest[i].version(4)
+			rest[i].variant(rfc4122)
+		}
+
+		rest = rest[n:]
+	}
+
+	clear(buf[:])
+
+	return g.outputBatch(dst)
+}
@@ -0,0 +1,71 @@
+package uuid
+
+import "testing"
+
+// ones is a rand source of nothing but 1 bits
+type ones struct{}
+
+func (ones) Read(b []byte) (int, error) {
+
+	for i := range b {
+		b[i] = 0xFF
+	}
+
+	return len(b), nil
+}
+
+func TestNewV4Batch(t *testing.T) {
+
+	uuids := NewV4Batch(testSize)
+
+	if len(uuids) != testSize {
+		t.Fatal("batch should be:", testSize, "got:", len(uuids))
+	}
+
+	seen := make(map[UUID]bool, testSize)
+
+	for _, uuid := range uuids {
+		if uuid.Version() != 4 || uuid.Variant() != VariantRFC4122 {
+			t.Fatal("not a v4:", uuid)
+		}
+
+		if seen[uuid] {
+			t.Fatal("duplicate in batch:", uuid)
+		}
+
+		seen[uuid] = true
+	}
+
+	if n := len(NewV4Batch(0)); n != 0 {
+		t.Error("empty batch should be:", 0, "got:", n)
+	}
+}
+
+func TestFillV4(t *testing.T) {
+
+	g := NewGenerator(WithRandSource(ones{}))
+	dst := make([]UUID, 300)
+
+	if err := g.FillV4(dst); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, uuid := range dst {
+		if uuid.String() != "ffffffff-ffff-4fff-bfff-ffffffffffff" {
+			t.Fatal("FillV4 should read from the Generator's source, got:", uuid)
+		}
+	}
+
+	if _, err := NewGenerator(WithReadOnly()).NewV4Batch(1); err != ErrReadOnly {
+		t.Error("read only Generator should return ErrReadOnly, got:", err)
+	}
+}
+
+func BenchmarkV4Batch(b *testing.B) {
+
+	dst := make([]UUID, 1000)
+
+	for n := 0; n < b.N; n += len(dst) {
+		defaultGenerator.FillV4(dst)
+	}
+}
@@ -0,0 +1,83 @@
+package uuid
+
+// Version is the 4 bit version number of a UUID, https://tools.ietf.org/html/rfc4122#section-4.1.3
+type Version byte
+
+// Variant is the layout a UUID follows, stored in the top bits of byte 8.
+// The values are the 3 bit patterns from https://tools.ietf.org/html/rfc4122#section-4.1.1
+// with the don't care bits set to 0
+type Variant byte
+
+const (
+	VariantNCS       Variant = ncs       // 0xx reserved, NCS backward compatibility
+	VariantRFC4122   Variant = rfc4122   // 10x the variant this package creates
+	VariantMicrosoft Variant = microsoft // 110 reserved, Microsoft backward compatibility
+	VariantFuture    Variant = future    // 111 reserved for future definition
+)
+
+// SetVersionBits sets the version of the UUID held in b, for building custom UUIDs in caller
+// owned buffers without allocating. b must be at least 16 bytes long; shorter buffers panic
+// before anything is written
+func SetVersionBits(b []byte, v Version) {
+	_ = b[uuidSize-1]
+	b[6] = (b[6] & 0x0F) | (byte(v) << 4)
+}
+
+// SetVariantBits sets the variant of the UUID held in b, keeping the bits after the variant.
+// Any byte 0-7 can be given, only the 3 low bits are used. b must be at least 16 bytes long;
+// shorter buffers panic before anything is written
+func SetVariantBits(b []byte, variant Variant) {
+
+	_ = b[uuidSize-1]
+
+	var mask byte
+
+	//0x7F clear top 1
+	//0x3F clear top 2
+	//0x1F clear top 3
+
+	switch variant & 0x07 {
+	case 0, 1, 2, 3:
+		mask = 0x7F
+	case 4, 5:
+		mask = 0x3F
+	default:
+		mask = 0x1F
+	}
+
+	b[8] = (b[8] & mask) | ((byte(variant) & 0x07) << 5 & ^mask)
+}
+
+// Version reads back the version number of u, 0 for Nil and 15 for Max
+func (u UUID) Version() Version {
+	return Version(u[6] >> 4)
+}
+
+// Variant reads back the layout of u from the top bits of byte 8
+func (u UUID) Variant() Variant {
+
+	switch {
+	case u[8]&0x80 == 0x00:
+		return VariantNCS
+	case u[8]&0xC0 == 0x80:
+		return VariantRFC4122
+	case u[8]&0xE0 == 0xC0:
+		return VariantMicrosoft
+	}
+
+	return VariantFuture
+}
+
+func (v Variant) String() string {
+	switch v {
+	case VariantNCS:
+		return "NCS"
+	case VariantRFC4122:
+		return "RFC4122"
+	case VariantMicrosoft:
+		return "Microsoft"
+	case VariantFuture:
+		return "Future"
+	}
+	return "unknown"
+}
@@ -0,0 +1,82 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestSetVariantBits(t *testing.T) {
+
+	tests := []struct {
+		variant   Variant
+		top, want byte // mask of the variant bits in byte 8 and their value
+	}{
+		{VariantNCS, 0x80, 0x00},
+		{VariantRFC4122, 0xC0, 0x80},
+		{VariantMicrosoft, 0xE0, 0xC0},
+		{VariantFuture, 0xE0, 0xE0},
+	}
+
+	for _, test := range tests {
+		for i := 0; i <= 0xFF; i++ {
+			b := make([]byte, uuidSize)
+			b[8] = byte(i)
+
+			SetVariantBits(b, test.variant)
+
+			if b[8]&test.top != test.want || b[8]&^test.top != byte(i)&^test.top {
+				t.Fatal("SetVariantBits", test.variant, "on", i, "is", b[8])
+			}
+		}
+	}
+}
+
+func TestSetVersionBits(t *testing.T) {
+
+	b := make([]byte, uuidSize)
+	b[6] = 0xFF
+
+	SetVersionBits(b, 7)
+
+	if b[6] != 0x7F {
+		t.Error("SetVersionBits is", b[6])
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("SetVersionBits did not panic on a short buffer")
+		}
+	}()
+
+	SetVersionBits(make([]byte, 8), 4)
+}
+
+func TestVersionVariant(t *testing.T) {
+
+	v3, _ := NewV3(NamespaceDNS, "name")
+
+	tests := []struct {
+		uuid    UUID
+		version Version
+		variant Variant
+	}{
+		{NewV1(), 1, VariantRFC4122},
+		{v3, 3, VariantRFC4122},
+		{NewV4(), 4, VariantRFC4122},
+		{NewV7(), 7, VariantRFC4122},
+		{Nil, 0, VariantNCS},
+		{Max, 15, VariantFuture},
+	}
+
+	for _, test := range tests {
+		if test.uuid.Version() != test.version || test.uuid.Variant() != test.variant {
+			t.Error(test.uuid.String(), "should be:", test.version, test.variant, "got:", test.uuid.Version(), test.uuid.Variant())
+		}
+	}
+
+	var guid UUID
+	SetVariantBits(guid[:], VariantMicrosoft)
+
+	if guid.Variant() != VariantMicrosoft {
+		t.Error("Variant should be:", VariantMicrosoft, "got:", guid.Variant())
+	}
+}
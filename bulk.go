@@ -0,0 +1,79 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError records which input of a bulk call failed and why
+type ParseError struct {
+	Index int
+	Input string
+	Err   error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("uuid %d %q: %v", e.Index, e.Input, e.Err)
+}
+
+// Unwrap lets errors.Is match the underlying error (e.g. ErrUUIDFormat)
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// MultiError holds every failure of a bulk call in input order
+type MultiError []*ParseError
+
+func (m MultiError) Error() string {
+
+	msgs := make([]string, len(m))
+
+	for i, e := range m {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap lets errors.Is and errors.As inspect each failure
+func (m MultiError) Unwrap() []error {
+
+	errs := make([]error, len(m))
+
+	for i, e := range m {
+		errs[i] = e
+	}
+
+	return errs
+}
+
+// ParseAll runs FromString over every string. Rows that fail are left as the zero UUID and
+// reported together in a MultiError, so callers can report each bad row in one pass
+func ParseAll(ss []string) ([]UUID, error) {
+
+	var errs MultiError
+	uuids := make([]UUID, len(ss))
+
+	for i, s := range ss {
+		uuid, err := FromString(s)
+
+		if err != nil {
+			errs = append(errs, &ParseError{Index: i, Input: s, Err: err})
+			continue
+		}
+
+		uuids[i] = uuid
+	}
+
+	if errs != nil {
+		return uuids, errs
+	}
+
+	return uuids, nil
+}
+
+// ValidateAll reports every string that FromString would reject as a MultiError
+func ValidateAll(ss []string) error {
+	_, err := ParseAll(ss)
+	return err
+}
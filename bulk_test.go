@@ -0,0 +1,40 @@
+package uuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseAll(t *testing.T) {
+
+	ss := []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b814-9dad-91d1-80b4-00c04fd430c8", // wrong version
+		"6ba7b811-9dad-11d1-80b4-00c04fd430c8",
+		"zz",
+	}
+
+	uuids, err := ParseAll(ss)
+
+	var multi MultiError
+
+	if !errors.As(err, &multi) {
+		t.Fatal("ParseAll did not return a MultiError", err)
+	}
+
+	if len(multi) != 2 || multi[0].Index != 1 || multi[1].Index != 3 {
+		t.Error("ParseAll reported the wrong rows", err)
+	}
+
+	if !errors.Is(err, ErrUUIDFormat) {
+		t.Error("ParseAll error does not wrap ErrUUIDFormat")
+	}
+
+	if uuids[0] != DNSNamespace {
+		t.Error("ParseAll did not parse good rows", uuids[0].String())
+	}
+
+	if err = ValidateAll(ss[:1]); err != nil {
+		t.Error("ValidateAll rejected a good row", err)
+	}
+}
@@ -0,0 +1,57 @@
+package uuid
+
+import (
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/binary"
+)
+
+// EncodeCapability returns a signed reference to the resource u that carries the scopes it grants.
+// The token is the UUID, each scope prefixed by its uvarint length and a 16 byte HMAC-SHA256 of it all,
+// as unpadded base64url. Scopes are readable by anyone holding the token
+func EncodeCapability(u UUID, scopes []string, key []byte) string {
+
+	b := append(make([]byte, 0, uuidSize+signedTagSize+16*len(scopes)), u[:]...)
+
+	for _, s := range scopes {
+		b = binary.AppendUvarint(b, uint64(len(s)))
+		b = append(b, s...)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(append(b, signTag(capabilityLabel, key, b)...))
+}
+
+// DecodeCapability verifies a token made by EncodeCapability and returns its UUID and scopes
+func DecodeCapability(token string, key []byte) (UUID, []string, error) {
+
+	var uuid UUID
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+
+	if err != nil || len(b) < uuidSize+signedTagSize {
+		return uuid, nil, ErrTokenInvalid
+	}
+
+	body, tag := b[:len(b)-signedTagSize], b[len(b)-signedTagSize:]
+
+	if !hmac.Equal(signTag(capabilityLabel, key, body), tag) {
+		return uuid, nil, ErrTokenInvalid
+	}
+
+	copy(uuid[:], body)
+
+	var scopes []string
+
+	for rest := body[uuidSize:]; len(rest) > 0; {
+		n, size := binary.Uvarint(rest)
+
+		if size <= 0 || uint64(len(rest)-size) < n {
+			return UUID{}, nil, ErrTokenInvalid
+		}
+
+		scopes = append(scopes, string(rest[size:size+int(n)]))
+		rest = rest[size+int(n):]
+	}
+
+	return uuid, scopes, nil
+}
@@ -0,0 +1,59 @@
+package uuid
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCapability(t *testing.T) {
+
+	key := []byte("capability secret")
+	uuid := NewV4()
+
+	for _, scopes := range [][]string{nil, {"read"}, {"read", "write", ""}} {
+		token := EncodeCapability(uuid, scopes, key)
+
+		back, got, err := DecodeCapability(token, key)
+
+		if err != nil || back != uuid || !reflect.DeepEqual(got, scopes) {
+			t.Error("capability did not round trip", scopes, got, err)
+		}
+
+		if _, _, err = DecodeCapability(token, []byte("wrong")); err != ErrTokenInvalid {
+			t.Error("capability decoded with the wrong key", err)
+		}
+	}
+
+	// a scope can not be added without the key
+	token := EncodeCapability(uuid, []string{"read"}, key)
+	forged := EncodeCapability(uuid, []string{"read", "admin"}, []byte("guess"))
+
+	if _, _, err := DecodeCapability(forged, key); err != ErrTokenInvalid {
+		t.Error("forged capability decoded", err)
+	}
+
+	if _, _, err := DecodeCapability(token[:10], key); err != ErrTokenInvalid {
+		t.Error("short capability decoded", err)
+	}
+}
+
+func TestCapabilityNotSigned(t *testing.T) {
+
+	key := []byte("shared secret")
+	uuid := NewV4()
+
+	// one 7 byte scope makes the capability the same size as a signed token,
+	// with the scope bytes landing on a far future expiry
+	capability := EncodeCapability(uuid, []string{"\x7f\xff\xff\xff\xff\xff\xff"}, key)
+
+	if _, err := VerifyUUID(capability, key); err != ErrTokenInvalid {
+		t.Error("capability verify as signed token should be:", ErrTokenInvalid, "got:", err)
+	}
+
+	signed := SignUUID(uuid, key, time.Now().Add(time.Hour))
+
+	if _, _, err := DecodeCapability(signed, key); err != ErrTokenInvalid {
+		t.Error("signed token decode as capability should be:", ErrTokenInvalid, "got:", err)
+	}
+}
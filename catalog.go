@@ -0,0 +1,178 @@
+package uuid
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+)
+
+var (
+	// ErrCatalogConflict is returned when a name or a namespace is already in a Catalog
+	// with something else
+	ErrCatalogConflict = errors.New("namespace catalog conflict")
+
+	// ErrCatalogName is returned for an empty name
+	ErrCatalogName = errors.New("namespace catalog name is empty")
+)
+
+// Catalog is an inventory of the v3 and v5 namespaces an organization uses, by name, kept in a JSON
+// file such as {"orders": "c2b4f1a4-...", "users": "..."}. It refuses a name that is taken by another
+// namespace and a namespace that already has another name, so two teams cannot end up minting IDs
+// in the same namespace, or one name meaning two; registering the same pair again is fine.
+// A Catalog is safe for concurrent use, but only one process should write to its file
+type Catalog struct {
+	mu    sync.RWMutex
+	path  string
+	names map[string]UUID
+}
+
+// OpenCatalog loads the catalog in the file at path, which need not exist yet.
+// An empty path gives a catalog that is only kept in memory
+func OpenCatalog(path string) (*Catalog, error) {
+
+	c := &Catalog{path: path, names: make(map[string]UUID)}
+
+	if path == "" {
+		return c, nil
+	}
+
+	b, err := os.ReadFile(path)
+
+	if os.IsNotExist(err) {
+		return c, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var names map[string]UUID
+
+	if err = json.Unmarshal(b, &names); err != nil {
+		return nil, err
+	}
+
+	// the file may have been edited by hand, sort so the conflict reported does not change
+	keys := make([]string, 0, len(names))
+
+	for name := range names {
+		keys = append(keys, name)
+	}
+
+	sort.Strings(keys)
+
+	for _, name := range keys {
+		if err = c.add(name, names[name]); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+
+	return c, nil
+}
+
+// Register adds ns under name and saves the file. It returns an error wrapping ErrCatalogConflict
+// if name or ns is already registered with something else, and leaves the catalog as it was
+func (c *Catalog) Register(name string, ns UUID) error {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if old, ok := c.names[name]; ok && old == ns {
+		return nil
+	}
+
+	if err := c.add(name, ns); err != nil {
+		return err
+	}
+
+	if err := c.save(); err != nil {
+		delete(c.names, name)
+		return err
+	}
+
+	return nil
+}
+
+// Lookup returns the namespace registered under name
+func (c *Catalog) Lookup(name string) (UUID, bool) {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	ns, ok := c.names[name]
+
+	return ns, ok
+}
+
+// Name returns the name ns is registered under
+func (c *Catalog) Name(ns UUID) (string, bool) {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for name, u := range c.names {
+		if u == ns {
+			return name, true
+		}
+	}
+
+	return "", false
+}
+
+// Names returns every registered name in sorted order
+func (c *Catalog) Names() []string {
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	names := make([]string, 0, len(c.names))
+
+	for name := range c.names {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// add checks for conflicts and adds the pair. c.mu must be held
+func (c *Catalog) add(name string, ns UUID) error {
+
+	if name == "" {
+		return ErrCatalogName
+	}
+
+	if old, ok := c.names[name]; ok {
+		return fmt.Errorf("%w: %q is already %s", ErrCatalogConflict, name, old)
+	}
+
+	for other, u := range c.names {
+		if u == ns {
+			return fmt.Errorf("%w: %s is already %q", ErrCatalogConflict, ns, other)
+		}
+	}
+
+	c.names[name] = ns
+
+	return nil
+}
+
+// save replaces the file, see FileStateStore. c.mu must be held
+func (c *Catalog) save() error {
+
+	if c.path == "" {
+		return nil
+	}
+
+	b, err := json.MarshalIndent(c.names, "", "\t")
+
+	if err != nil {
+		return err
+	}
+
+	return replaceFile(c.path, append(b, '\n'))
+}
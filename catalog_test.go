@@ -0,0 +1,127 @@
+package uuid
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCatalogConfigs(t *testing.T) {
+
+	defer Configure(Config{})
+
+	for _, c := range configCombinations {
+		if err := Configure(c); err != nil {
+			t.Fatal(err)
+		}
+
+		path := filepath.Join(t.TempDir(), "namespaces.json")
+		catalog, err := OpenCatalog(path)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ns := NewV4()
+
+		if err = catalog.Register("orders", ns); err != nil {
+			t.Fatal(err)
+		}
+
+		if catalog, err = OpenCatalog(path); err != nil {
+			t.Fatal("catalog could not reread its file under", c, err)
+		}
+
+		if got, ok := catalog.Lookup("orders"); !ok || got != ns {
+			t.Error("catalog did not round trip under", c, "got:", got.String())
+		}
+	}
+}
+
+func TestCatalog(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "namespaces.json")
+	c, err := OpenCatalog(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	orders, users := NewV4(), NewV4()
+
+	if err = c.Register("orders", orders); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Register("users", users); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = c.Register("orders", orders); err != nil {
+		t.Error("registering the same pair again should be fine, got:", err)
+	}
+
+	if err = c.Register("orders", NewV4()); !errors.Is(err, ErrCatalogConflict) {
+		t.Error("a taken name should be:", ErrCatalogConflict, "got:", err)
+	}
+
+	if err = c.Register("customers", users); !errors.Is(err, ErrCatalogConflict) {
+		t.Error("a namespace with a name should be:", ErrCatalogConflict, "got:", err)
+	}
+
+	if err = c.Register("", NewV4()); err != ErrCatalogName {
+		t.Error("empty name should be:", ErrCatalogName, "got:", err)
+	}
+
+	// reopen from the file
+	c, err = OpenCatalog(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ns, ok := c.Lookup("orders"); !ok || ns != orders {
+		t.Error("orders should be:", orders, "got:", ns, ok)
+	}
+
+	if name, ok := c.Name(users); !ok || name != "users" {
+		t.Error("users namespace should be named:", "users", "got:", name, ok)
+	}
+
+	if _, ok := c.Lookup("customers"); ok {
+		t.Error("a refused namespace should not be saved")
+	}
+
+	if names := c.Names(); len(names) != 2 || names[0] != "orders" || names[1] != "users" {
+		t.Error("names should be:", []string{"orders", "users"}, "got:", names)
+	}
+}
+
+func TestOpenCatalogConflict(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "namespaces.json")
+	edited := `{"dns": "6ba7b810-9dad-11d1-80b4-00c04fd430c8", "hosts": "6ba7b810-9dad-11d1-80b4-00c04fd430c8"}`
+
+	if err := os.WriteFile(path, []byte(edited), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OpenCatalog(path); !errors.Is(err, ErrCatalogConflict) {
+		t.Error("a file with a namespace twice should be:", ErrCatalogConflict, "got:", err)
+	}
+
+	if err := os.WriteFile(path, []byte(`{"dns": "not a uuid"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := OpenCatalog(path); err == nil {
+		t.Error("a file with a bad UUID should not open")
+	}
+
+	c, err := OpenCatalog("")
+
+	if err != nil || c.Register("dns", NamespaceDNS) != nil {
+		t.Error("a memory only catalog should register, got:", err)
+	}
+}
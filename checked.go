@@ -0,0 +1,58 @@
+package uuid
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"strings"
+)
+
+// crockfordAlphabet leaves out I, L, O and U to avoid confusing them with 1, 0 and V
+// See https://www.crockford.com/base32.html
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+var (
+	crockford = base32.NewEncoding(crockfordAlphabet).WithPadding(base32.NoPadding)
+
+	// typed look alikes are read as the digit they resemble
+	crockfordNormalizer = strings.NewReplacer("-", "", "I", "1", "L", "1", "O", "0")
+
+	// ErrChecksum is returned by DecodeChecked when the check digits do not match
+	ErrChecksum = errors.New("UUID checksum does not match")
+)
+
+// EncodeChecked encodes the UUID and the low 16 bits of its CRC-32 as 29 characters of Crockford
+// base32, so a mistyped ID (support tickets, invoices) is caught by DecodeChecked
+func (u UUID) EncodeChecked() string {
+
+	var b [uuidSize + 2]byte
+
+	copy(b[:], u[:])
+	binary.BigEndian.PutUint16(b[uuidSize:], uint16(crc32.ChecksumIEEE(u[:])))
+
+	return crockford.EncodeToString(b[:])
+}
+
+// DecodeChecked decodes the form written by EncodeChecked.
+// It ignores case and hyphens and reads I and L as 1 and O as 0
+func DecodeChecked(s string) (UUID, error) {
+
+	s = crockfordNormalizer.Replace(strings.ToUpper(s))
+
+	b, err := crockford.DecodeString(s)
+
+	if err != nil {
+		return UUID{}, err
+	}
+
+	if len(b) != uuidSize+2 {
+		return UUID{}, ErrUUIDSize
+	}
+
+	if binary.BigEndian.Uint16(b[uuidSize:]) != uint16(crc32.ChecksumIEEE(b[:uuidSize])) {
+		return UUID{}, ErrChecksum
+	}
+
+	return FromBytes(b[:uuidSize])
+}
@@ -0,0 +1,34 @@
+package uuid
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEncodeChecked(t *testing.T) {
+
+	uuid := NewV4()
+	s := uuid.EncodeChecked()
+
+	if len(s) != 29 {
+		t.Fatal("EncodeChecked is", len(s), "characters")
+	}
+
+	back, err := DecodeChecked(strings.ToLower(s[:10]) + "-" + s[10:])
+
+	if err != nil || back != uuid {
+		t.Error("DecodeChecked did not round trip", s, err)
+	}
+
+	// change one character
+	typo := []byte(s)
+	if typo[3] == 'A' {
+		typo[3] = 'B'
+	} else {
+		typo[3] = 'A'
+	}
+
+	if _, err = DecodeChecked(string(typo)); err != ErrChecksum {
+		t.Error("DecodeChecked did not catch typo", string(typo), err)
+	}
+}
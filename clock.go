@@ -0,0 +1,104 @@
+package uuid
+
+import (
+	"time"
+)
+
+// Clock is the time source of the time based UUIDs (v1, v2, v6 and v7).
+// The default is the system clock; tests can swap in a fake one with WithClock
+// (see the uuidtest package for a clock that drifts and jumps backwards)
+type Clock interface {
+	Now() time.Time
+}
+
+// WithClock makes the Generator read the time from c instead of the package clock
+// (the system clock unless Config.Clock is set)
+func WithClock(c Clock) Option {
+	return func(g *Generator) {
+		g.clock = c
+	}
+}
+
+// now is the Generator's current time
+func (g *Generator) now() time.Time {
+
+	if g.clock == nil {
+		return currentNow()
+	}
+
+	return g.clock.Now()
+}
+
+// currentNow is the time from Config.Clock, or the system clock
+func currentNow() time.Time {
+
+	if c := currentConfig().Clock; c != nil {
+		return c.Now()
+	}
+
+	return time.Now()
+}
+
+// FixedClock is a Clock stopped at a time, for giving NewV1WithTime and friends a single time
+type FixedClock time.Time
+
+func (c FixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+// NewV1WithTime is NewV1 with the time read from c for this call only, for backdating one UUID
+// among normal ones without a Generator of its own. See Generator.NewV1WithTime
+func NewV1WithTime(c Clock) UUID {
+	uuid, _ := defaultGenerator.NewV1WithTime(c)
+	return uuid
+}
+
+// NewV6WithTime is NewV1WithTime for v6
+func NewV6WithTime(c Clock) UUID {
+	uuid, _ := defaultGenerator.NewV6WithTime(c)
+	return uuid
+}
+
+// NewV7WithTime is NewV1WithTime for v7
+func NewV7WithTime(c Clock) UUID {
+	uuid, _ := defaultGenerator.NewV7WithTime(c)
+	return uuid
+}
+
+// NewV1WithTime is NewV1 with the time read from c instead of the Generator's clock, for this call only.
+// The time is kept out of the order of the Generator's other UUIDs: the next NewV1 carries on from
+// the last one as if this call had not happened, and this UUID gets a new clock sequence so it
+// cannot repeat one made from the clock at the same time
+func (g *Generator) NewV1WithTime(c Clock) (UUID, error) {
+	return g.newTime(&uuidTime{now: c.Now}, 1, insertTimestamp, true)
+}
+
+// NewV6WithTime is NewV1WithTime for v6
+func (g *Generator) NewV6WithTime(c Clock) (UUID, error) {
+	return g.newTime(&uuidTime{now: c.Now}, 6, insertTimestampV6, true)
+}
+
+// NewV7WithTime is NewV7 with the time read from c, for this call only. Like NewV1WithTime it does not
+// move the Generator's order, so the counter starts from random, and neither the sequence file nor
+// epoch leases are used
+func (g *Generator) NewV7WithTime(c Clock) (UUID, error) {
+
+	var uuid UUID
+
+	if err := g.allow(1); err != nil {
+		return uuid, err
+	}
+
+	ms := c.Now().UnixMilli()
+
+	if ms < 0 || ms > maxUnixMs {
+		return uuid, ErrTimestampRange
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.fillV7(&uuid, ms, g.randomCounter7())
+
+	return g.output(uuid)
+}
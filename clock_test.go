@@ -0,0 +1,96 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+	"time"
+)
+
+func TestWithClock(t *testing.T) {
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	g := NewGenerator(WithClock(FixedClock(now)))
+
+	uuid, err := g.NewV7()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ms := int64(binary.BigEndian.Uint64(uuid[:8]) >> 16); ms != now.UnixMilli() {
+		t.Error("v7 timestamp should be:", now.UnixMilli(), "got:", ms)
+	}
+
+	uuid, _ = g.NewV1()
+	ts, _ := getUUIDEpochTime(now)
+
+	if v1Timestamp(uuid) != ts {
+		t.Error("v1 timestamp should be:", ts, "got:", v1Timestamp(uuid))
+	}
+}
+
+func TestNewV1WithTime(t *testing.T) {
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	g := NewGenerator(WithClock(FixedClock(now)))
+
+	first, _ := g.NewV1()
+	back := now.Add(-24 * time.Hour)
+
+	for _, gen := range []func(Clock) (UUID, error){g.NewV1WithTime, g.NewV6WithTime, g.NewV7WithTime} {
+		uuid, err := gen(FixedClock(back))
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ts, _ := uuid.Time(); !ts.Equal(back) {
+			t.Error("time should be:", back, "got:", ts, uuid)
+		}
+	}
+
+	// the backdated UUIDs do not change the order of the clock's
+	next, _ := g.NewV1()
+
+	if v1Timestamp(next) != v1Timestamp(first)+1 {
+		t.Error("next v1 should be one tick after the first, got:", first, next)
+	}
+
+	// a time in the future does not drag the clock's UUIDs along
+	g.NewV1WithTime(FixedClock(now.Add(time.Hour)))
+
+	if last, _ := g.NewV1(); v1Timestamp(last) != v1Timestamp(next)+1 {
+		t.Error("v1 after a future time should carry on from the clock, got:", next, last)
+	}
+
+	// the same time twice still gives different UUIDs
+	a, _ := g.NewV1WithTime(FixedClock(back))
+	b, _ := g.NewV1WithTime(FixedClock(back))
+
+	if a == b {
+		t.Error("two v1s from the same time should differ:", a)
+	}
+
+	v7, _ := g.NewV7()
+
+	if late, _ := g.NewV7WithTime(FixedClock(now.Add(time.Hour))); bytes.Compare(late[:], v7[:]) <= 0 {
+		t.Error("v7 should be an hour later, got:", late)
+	}
+
+	if after, _ := g.NewV7(); bytes.Compare(after[:], v7[:]) <= 0 {
+		t.Error("v7 should keep its order, got:", v7, after)
+	}
+
+	if _, err := g.NewV7WithTime(FixedClock(time.Unix(-1, 0))); err != ErrTimestampRange {
+		t.Error("v7 before 1970 should be:", ErrTimestampRange, "got:", err)
+	}
+
+	if _, err := NewGenerator(WithReadOnly()).NewV1WithTime(FixedClock(now)); err != ErrReadOnly {
+		t.Error("read only should be:", ErrReadOnly, "got:", err)
+	}
+
+	if uuid := NewV1WithTime(FixedClock(back)); uuid.Version() != 1 {
+		t.Error("package level NewV1WithTime should be a v1, got:", uuid)
+	}
+}
@@ -0,0 +1,81 @@
+package uuid
+
+import "encoding/base64"
+
+// compactSize is the length of the unpadded base64url form of 16 bytes
+const compactSize = 22
+
+// compactEncoding is strict so the 2 unused bits of the last character must be 0,
+// giving every UUID a single compact form
+var compactEncoding = base64.RawURLEncoding.Strict()
+
+// Base64 is the 22 character unpadded base64url form, e.g. a6e4EJ2tEdGAtADAT9QwyA, for bandwidth
+// sensitive APIs. It is URL safe but case sensitive, so it does not suit hand typed codes
+func (u UUID) Base64() string {
+	return compactEncoding.EncodeToString(u[:])
+}
+
+// FromBase64 reads the form written by Base64. Like FromString it then checks the bits with FromBytes
+func FromBase64(s string) (UUID, error) {
+
+	var b [uuidSize]byte
+
+	if len(s) != compactSize {
+		return UUID{}, ErrUUIDFormat
+	}
+
+	if _, err := compactEncoding.Decode(b[:], []byte(s)); err != nil {
+		return UUID{}, ErrUUIDFormat
+	}
+
+	return FromBytes(b[:])
+}
+
+// Compact is a UUID that marshals to text, and so to JSON, as Base64 instead of String:
+//
+//	type Order struct {
+//		ID uuid.Compact `json:"id"`
+//	}
+//
+// Both Compact and UUID unmarshal either form, so a client and server can switch one at a time.
+// With StrictParse a UUID only reads the 8-4-4-4-12 form, while a Compact still reads both
+type Compact UUID
+
+// MarshalText implements encoding.TextMarshaler with the Base64 form
+func (c Compact) MarshalText() ([]byte, error) {
+	return c.AppendText(make([]byte, 0, compactSize))
+}
+
+// AppendText implements encoding.TextAppender with the Base64 form. Like UUID.AppendText
+// it returns ErrInconsistent under Config.StrictOutput for bad version or variant bits
+func (c Compact) AppendText(b []byte) ([]byte, error) {
+
+	if err := checkOutput(UUID(c)); err != nil {
+		return b, err
+	}
+
+	return compactEncoding.AppendEncode(b, c[:]), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting what UUID.UnmarshalText accepts.
+// The Base64 form is read whatever the Config, as it is what MarshalText writes
+func (c *Compact) UnmarshalText(text []byte) error {
+
+	if len(text) != compactSize {
+		return (*UUID)(c).UnmarshalText(text)
+	}
+
+	uuid, err := FromBase64(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	*c = Compact(uuid)
+
+	return nil
+}
+
+func (c Compact) String() string {
+	return UUID(c).Base64()
+}
@@ -0,0 +1,99 @@
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBase64(t *testing.T) {
+
+	if s := NamespaceDNS.Base64(); s != "a6e4EJ2tEdGAtADAT9QwyA" {
+		t.Error("Base64 should be:", "a6e4EJ2tEdGAtADAT9QwyA", "got:", s)
+	}
+
+	for i := 0; i < testSize/100; i++ {
+		uuid := NewV4()
+
+		if back, err := FromBase64(uuid.Base64()); err != nil || back != uuid {
+			t.Fatal("Base64 did not round trip", uuid, err)
+		}
+	}
+
+	// too short, not base64url, and unused bits set in the last character
+	for _, s := range []string{"a6e4EJ2tEdGAtADAT9Qwy", "a6e4EJ2tEdGAtADAT9Qwy+", "a6e4EJ2tEdGAtADAT9QwyB"} {
+		if _, err := FromBase64(s); err != ErrUUIDFormat {
+			t.Error("FromBase64 should reject", s, "got:", err)
+		}
+	}
+}
+
+func TestCompactJSON(t *testing.T) {
+
+	type row struct {
+		ID   Compact
+		Full UUID
+	}
+
+	in := row{ID: Compact(NamespaceDNS), Full: NamespaceURL}
+	b, err := json.Marshal(in)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"ID":"a6e4EJ2tEdGAtADAT9QwyA","Full":"6ba7b811-9dad-11d1-80b4-00c04fd430c8"}`; string(b) != want {
+		t.Error("json should be:", want, "got:", string(b))
+	}
+
+	var out row
+
+	if err = json.Unmarshal(b, &out); err != nil || out != in {
+		t.Error("json did not round trip", out, err)
+	}
+
+	// each side reads the other's form
+	swapped := `{"ID":"6ba7b810-9dad-11d1-80b4-00c04fd430c8","Full":"a6e4EJ2tEdGAtADAT9QwyB"}`
+
+	if err = json.Unmarshal([]byte(swapped), &out); err == nil {
+		t.Error("json should reject a bad compact form")
+	}
+
+	swapped = `{"ID":"6ba7b810-9dad-11d1-80b4-00c04fd430c8","Full":"a6e4EJ2tEdGAtADAT9QwyA"}`
+
+	if err = json.Unmarshal([]byte(swapped), &out); err != nil || UUID(out.ID) != NamespaceDNS || out.Full != NamespaceDNS {
+		t.Error("either form should unmarshal into either type", out, err)
+	}
+
+	if s := Compact(NamespaceDNS).String(); s != "a6e4EJ2tEdGAtADAT9QwyA" {
+		t.Error("Compact should print as Base64, got:", s)
+	}
+
+	defer Configure(Config{})
+	Configure(Config{StrictParse: true})
+
+	if err = out.Full.UnmarshalText([]byte("a6e4EJ2tEdGAtADAT9QwyA")); err == nil {
+		t.Error("StrictParse should only accept the canonical form")
+	}
+
+	var c Compact
+
+	if err = c.UnmarshalText([]byte("a6e4EJ2tEdGAtADAT9QwyA")); err != nil || UUID(c) != NamespaceDNS {
+		t.Error("Compact should read its own form under StrictParse, got:", err)
+	}
+
+	for _, cfg := range configCombinations {
+		if err = Configure(cfg); err != nil {
+			t.Fatal(err)
+		}
+
+		in := struct{ ID Compact }{Compact(NewV4())}
+		js, _ := json.Marshal(in)
+
+		back := in
+		back.ID = Compact{}
+
+		if err = json.Unmarshal(js, &back); err != nil || back != in {
+			t.Error("Compact json did not round trip under", cfg, string(js), err)
+		}
+	}
+}
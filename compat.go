@@ -0,0 +1,58 @@
+package uuid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// InterfaceReport says whether UUID (Value) and *UUID (Pointer) satisfy a standard library interface
+type InterfaceReport struct {
+	Name    string
+	Value   bool
+	Pointer bool
+}
+
+var compatInterfaces = []struct {
+	name string
+	typ  reflect.Type
+}{
+	{"fmt.Stringer", reflect.TypeOf((*fmt.Stringer)(nil)).Elem()},
+	{"fmt.Formatter", reflect.TypeOf((*fmt.Formatter)(nil)).Elem()},
+	{"encoding.TextMarshaler", reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()},
+	{"encoding.TextUnmarshaler", reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()},
+	{"encoding.TextAppender", reflect.TypeOf((*encoding.TextAppender)(nil)).Elem()},
+	{"encoding.BinaryMarshaler", reflect.TypeOf((*encoding.BinaryMarshaler)(nil)).Elem()},
+	{"encoding.BinaryUnmarshaler", reflect.TypeOf((*encoding.BinaryUnmarshaler)(nil)).Elem()},
+	{"encoding.BinaryAppender", reflect.TypeOf((*encoding.BinaryAppender)(nil)).Elem()},
+	{"encoding/json.Marshaler", reflect.TypeOf((*json.Marshaler)(nil)).Elem()},
+	{"encoding/json.Unmarshaler", reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()},
+	{"encoding/gob.GobEncoder", reflect.TypeOf((*gob.GobEncoder)(nil)).Elem()},
+	{"encoding/gob.GobDecoder", reflect.TypeOf((*gob.GobDecoder)(nil)).Elem()},
+	{"database/sql.Scanner", reflect.TypeOf((*sql.Scanner)(nil)).Elem()},
+	{"database/sql/driver.Valuer", reflect.TypeOf((*driver.Valuer)(nil)).Elem()},
+}
+
+// CompatibilityReport lists the standard library encoding interfaces and whether UUID and *UUID
+// satisfy each, so downstream teams can gate upgrades on interface coverage
+func CompatibilityReport() []InterfaceReport {
+
+	value := reflect.TypeOf(UUID{})
+	pointer := reflect.PointerTo(value)
+
+	report := make([]InterfaceReport, len(compatInterfaces))
+
+	for i, c := range compatInterfaces {
+		report[i] = InterfaceReport{
+			Name:    c.name,
+			Value:   value.Implements(c.typ),
+			Pointer: pointer.Implements(c.typ),
+		}
+	}
+
+	return report
+}
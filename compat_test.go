@@ -0,0 +1,25 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestCompatibilityReport(t *testing.T) {
+
+	report := CompatibilityReport()
+
+	if len(report) != len(compatInterfaces) {
+		t.Fatal("CompatibilityReport has", len(report), "entries")
+	}
+
+	for _, r := range report {
+		// a value's methods are always a pointer's methods too
+		if r.Value && !r.Pointer {
+			t.Error(r.Name, "is satisfied by UUID but not *UUID")
+		}
+
+		if r.Name == "fmt.Stringer" && !r.Pointer {
+			t.Error("*UUID should be a fmt.Stringer")
+		}
+	}
+}
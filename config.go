@@ -0,0 +1,114 @@
+package uuid
+
+import (
+	"errors"
+	"io"
+	"sync/atomic"
+)
+
+// Case is the letter case String uses for hex digits
+type Case int
+
+const (
+	// LowerCase is the RFC4122 output case
+	LowerCase Case = iota
+
+	// UpperCase is what some Microsoft tools expect
+	UpperCase
+)
+
+var (
+	config        atomic.Pointer[Config] // immutable snapshot, replaced whole by Configure
+	defaultConfig Config                 // used until Configure is called
+
+	// ErrConfig is returned by Configure for a Config it cannot apply
+	ErrConfig = errors.New("invalid uuid config")
+)
+
+// Config holds the package wide defaults set by Configure. The zero value is the package's
+// behaviour when Configure is never called
+type Config struct {
+	DefaultVersion int       // version created by New: 1, 2 (NewDCEPerson), 4, 6 or 7. 0 means 4
+	OutputCase     Case      // case of String
+	StrictParse    bool      // FromString only accepts the lowercase 8-4-4-4-12 form (see IsCanonical), UnmarshalText it in either case
+	Rand           io.Reader // source of random bits, crypto/rand when nil. See SetRandSource
+	BinaryColumns  bool      // Value gives the raw 16 bytes instead of text. See PreferBinaryColumns
+	Clock          Clock     // source of time, the system clock when nil. See WithClock and EnableSimulation
+	StrictOutput   bool      // MarshalText, MarshalBinary and Value return ErrInconsistent for bad version or variant bits
+}
+
+// Configure sets package wide defaults, usually once during startup. They apply to the package
+// level constructors (the default Generator), String, FromString and the encodings.
+// The whole Config is validated first and then swapped in atomically, so a call in progress
+// sees either the old or the new Config, never a mix; on error nothing changes.
+// Reading the Config is a single atomic load, it is safe to call Configure at any time
+func Configure(c Config) error {
+
+	switch c.DefaultVersion {
+	case 0:
+		c.DefaultVersion = 4
+	case 1, 2, 4, 6, 7:
+	default:
+		return ErrConfig // v3 and v5 need a name
+	}
+
+	if c.OutputCase != LowerCase && c.OutputCase != UpperCase {
+		return ErrConfig
+	}
+
+	config.Store(&c)
+
+	return nil
+}
+
+// currentConfig returns the Config in use. It must not be modified
+func currentConfig() *Config {
+
+	if c := config.Load(); c != nil {
+		return c
+	}
+
+	return &defaultConfig
+}
+
+// SetRandSource replaces the source of every random bit the package uses (v4 and v7 bits,
+// clock sequences and random node IDs), leaving the rest of the Config as it is.
+// The default, and nil, is crypto/rand. Tests and deterministic environments can pass a seeded
+// reader; it must be safe for concurrent use. A read error panics, as crypto/rand failing would
+func SetRandSource(r io.Reader) {
+	updateConfig(func(c *Config) {
+		c.Rand = r
+	})
+}
+
+// updateConfig applies set to a copy of the current Config and swaps it in,
+// retrying if Configure or another update got there first
+func updateConfig(set func(*Config)) {
+
+	for {
+		old := config.Load()
+		c := *currentConfig()
+		set(&c)
+
+		if config.CompareAndSwap(old, &c) {
+			return
+		}
+	}
+}
+
+// New creates a UUID of the Config's DefaultVersion with the default Generator
+func New() UUID {
+
+	switch currentConfig().DefaultVersion {
+	case 1:
+		return NewV1()
+	case 2:
+		return NewDCEPerson()
+	case 6:
+		return NewV6()
+	case 7:
+		return NewV7()
+	}
+
+	return NewV4()
+}
@@ -0,0 +1,136 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestConfigure(t *testing.T) {
+
+	defer Configure(Config{})
+
+	if err := Configure(Config{DefaultVersion: 3}); err != ErrConfig {
+		t.Error("Configure accepted v3 as default", err)
+	}
+
+	if err := Configure(Config{OutputCase: Case(5)}); err != ErrConfig {
+		t.Error("Configure accepted an unknown case", err)
+	}
+
+	if err := Configure(Config{DefaultVersion: 1, OutputCase: UpperCase, StrictParse: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	uuid := New()
+
+	if uuid[6]>>4 != 1 {
+		t.Error("New did not use DefaultVersion", uuid.String())
+	}
+
+	if s := DNSNamespace.String(); s != "6BA7B810-9DAD-11D1-80B4-00C04FD430C8" {
+		t.Error("String did not use OutputCase", s)
+	}
+
+	if _, err := FromString("6ba7b8109dad11d180b400c04fd430c8"); err != ErrUUIDFormat {
+		t.Error("FromString did not use StrictParse", err)
+	}
+
+	// the default
+	if err := Configure(Config{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if uuid = New(); uuid[6]>>4 != 4 {
+		t.Error("New should default to v4", uuid.String())
+	}
+}
+
+func TestConfigureHelpers(t *testing.T) {
+
+	defer Configure(Config{})
+
+	if err := Configure(Config{OutputCase: UpperCase, StrictParse: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	const s = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+	if uuid, err := ParseGroups(DNSNamespace.FormatGroups(" ", []int{8, 8, 8, 8})); err != nil || uuid != DNSNamespace {
+		t.Error("ParseGroups should ignore StrictParse, got:", err)
+	}
+
+	if uuid, err := FromStringLenient("6BA7B810-9DAD-11D1-80B4-00C04FD430C8"); err != nil || uuid != DNSNamespace {
+		t.Error("FromStringLenient should ignore StrictParse, got:", err)
+	}
+
+	if urn := DNSNamespace.URN(); urn != "urn:uuid:"+s {
+		t.Error("URN should be:", "urn:uuid:"+s, "got:", urn)
+	}
+
+	if ni := DNSNamespace.NI(); ni != "ni:///uuid;"+s {
+		t.Error("NI should be:", "ni:///uuid;"+s, "got:", ni)
+	}
+
+	if uri := DNSNamespace.FormatURI("{uuid} {hex}"); uri != s+" 6ba7b8109dad11d180b400c04fd430c8" {
+		t.Error("FormatURI should use one case, got:", uri)
+	}
+
+	if _, args := Postgres.InClause([]UUID{DNSNamespace}); args[0] != s {
+		t.Error("InClause should be:", s, "got:", args[0])
+	}
+}
+
+func TestConfigureConcurrent(t *testing.T) {
+
+	defer Configure(Config{})
+
+	done := make(chan struct{})
+
+	go func() {
+		for i := 0; i < 1000; i++ {
+			Configure(Config{OutputCase: Case(i % 2)})
+		}
+		close(done)
+	}()
+
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+
+		if s := DNSNamespace.String(); s != DNSNamespace.canonical() && s != "6BA7B810-9DAD-11D1-80B4-00C04FD430C8" {
+			t.Fatal("String saw a torn Config", s)
+		}
+	}
+}
+
+func TestCurrentConfigAllocs(t *testing.T) {
+
+	allocs := testing.AllocsPerRun(100, func() {
+		devNull(currentConfig().OutputCase)
+	})
+
+	if allocs != 0 {
+		t.Error("reading the Config allocates", allocs)
+	}
+}
+
+func TestSetRandSource(t *testing.T) {
+
+	defer SetRandSource(nil)
+
+	SetRandSource(bytes.NewReader(make([]byte, 16)))
+
+	if uuid := NewV4(); uuid.String() != "00000000-0000-4000-8000-000000000000" {
+		t.Error("NewV4 did not read the rand source", uuid.String())
+	}
+
+	g := NewGenerator(WithRandSource(bytes.NewReader(bytes.Repeat([]byte{0xFF}, 64))))
+	uuid, err := g.NewV4()
+
+	if err != nil || uuid.String() != "ffffffff-ffff-4fff-bfff-ffffffffffff" {
+		t.Error("Generator did not read its rand source", uuid.String(), err)
+	}
+}
@@ -0,0 +1,113 @@
+package uuid
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// CopyInserter streams newly generated IDs, optionally zipped with caller rows, into bulk loaders.
+// It has the Next/Values/Err methods of pgx's CopyFromSource so it can be passed straight to
+// CopyFrom, and Reader adapts it for MySQL's LOAD DATA LOCAL INFILE. Rows are generated only
+// as the loader asks for them, so a slow database never has millions of IDs waiting in memory
+type CopyInserter struct {
+	n   int
+	gen func() UUID
+	row func(i int, id UUID) ([]interface{}, error)
+
+	i   int
+	cur []interface{}
+	err error
+}
+
+// NewCopyInserter streams n IDs from gen (e.g. NewV4). Each row is the ID followed by the values
+// row returns for it; a nil row func gives single column rows. An error from row stops the copy
+func NewCopyInserter(n int, gen func() UUID, row func(i int, id UUID) ([]interface{}, error)) *CopyInserter {
+	return &CopyInserter{n: n, gen: gen, row: row}
+}
+
+// Next generates the next row, false when all n are done or row failed
+func (c *CopyInserter) Next() bool {
+
+	if c.err != nil || c.i >= c.n {
+		return false
+	}
+
+	id := c.gen()
+	c.cur = []interface{}{[16]byte(id)}
+
+	if c.row != nil {
+		vals, err := c.row(c.i, id)
+
+		if err != nil {
+			c.err = err
+			return false
+		}
+
+		c.cur = append(c.cur, vals...)
+	}
+
+	c.i++
+
+	return true
+}
+
+// Values returns the current row. The ID is a [16]byte, which pgx encodes into uuid columns
+func (c *CopyInserter) Values() ([]interface{}, error) {
+	return c.cur, c.err
+}
+
+// Err returns the error that stopped the copy, if any
+func (c *CopyInserter) Err() error {
+	return c.err
+}
+
+// Reader returns the rows as tab separated lines in the default LOAD DATA format, with the
+// ID in canonical form and nil as \N. Register it with the driver's reader handler and load with
+// e.g. LOAD DATA LOCAL INFILE 'Reader::ids' INTO TABLE t (@id, name) SET id = UUID_TO_BIN(@id)
+func (c *CopyInserter) Reader() io.Reader {
+	return &loadDataReader{c: c}
+}
+
+var loadDataEscaper = strings.NewReplacer("\\", "\\\\", "\t", "\\t", "\n", "\\n")
+
+type loadDataReader struct {
+	c   *CopyInserter
+	buf bytes.Buffer
+}
+
+func (r *loadDataReader) Read(p []byte) (int, error) {
+
+	for r.buf.Len() == 0 {
+		if !r.c.Next() {
+			if err := r.c.Err(); err != nil {
+				return 0, err
+			}
+
+			return 0, io.EOF
+		}
+
+		for i, v := range r.c.cur {
+			if i > 0 {
+				r.buf.WriteByte('\t')
+			}
+
+			switch v := v.(type) {
+			case nil:
+				r.buf.WriteString("\\N")
+			case [16]byte:
+				u := UUID(v)
+				r.buf.WriteString(u.canonical())
+			case UUID:
+				r.buf.WriteString(v.canonical())
+			default:
+				loadDataEscaper.WriteString(&r.buf, fmt.Sprint(v))
+			}
+		}
+
+		r.buf.WriteByte('\n')
+	}
+
+	return r.buf.Read(p)
+}
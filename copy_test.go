@@ -0,0 +1,74 @@
+package uuid
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestCopyInserter(t *testing.T) {
+
+	c := NewCopyInserter(3, NewV4, func(i int, id UUID) ([]interface{}, error) {
+		return []interface{}{i, "a\tb"}, nil
+	})
+
+	rows := 0
+
+	for c.Next() {
+		vals, err := c.Values()
+
+		if err != nil || len(vals) != 3 {
+			t.Fatal("CopyInserter row is", vals, err)
+		}
+
+		if _, ok := vals[0].([16]byte); !ok || vals[1] != rows {
+			t.Error("CopyInserter row is", vals)
+		}
+
+		rows++
+	}
+
+	if rows != 3 || c.Err() != nil {
+		t.Error("CopyInserter gave", rows, "rows", c.Err())
+	}
+}
+
+func TestCopyInserterReader(t *testing.T) {
+
+	ids := []UUID{DNSNamespace, URLNamespace}
+	gen := func() UUID {
+		id := ids[0]
+		ids = ids[1:]
+		return id
+	}
+
+	c := NewCopyInserter(2, gen, func(i int, id UUID) ([]interface{}, error) {
+		return []interface{}{"x\ty", nil}, nil
+	})
+
+	b, err := io.ReadAll(c.Reader())
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "6ba7b810-9dad-11d1-80b4-00c04fd430c8\tx\\ty\t\\N\n" +
+		"6ba7b811-9dad-11d1-80b4-00c04fd430c8\tx\\ty\t\\N\n"
+
+	if string(b) != want {
+		t.Error("CopyInserter Reader is", string(b))
+	}
+
+	fail := errors.New("row failed")
+	c = NewCopyInserter(5, NewV4, func(i int, id UUID) ([]interface{}, error) {
+		if i == 2 {
+			return nil, fail
+		}
+		return nil, nil
+	})
+
+	if b, err = io.ReadAll(c.Reader()); err != fail || strings.Count(string(b), "\n") != 2 {
+		t.Error("CopyInserter Reader did not stop at the failed row", err)
+	}
+}
@@ -0,0 +1,129 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os/user"
+	"strconv"
+)
+
+// dceSeqStep moves the clock sequence by one in the 6 bits a v2 keeps of it
+const dceSeqStep = 1 << 8
+
+// ErrDCESeqRange is returned by NewV2 when the clock sequence range (see EnableHostLock and
+// NodeSlot) leaves a v2 a single value of the 6 bits it keeps, so every v2 for a domain and id
+// would be the same until the timestamp ticks
+var ErrDCESeqRange = errors.New("clock sequence range is too narrow for v2 UUIDs")
+
+// Domain is the kind of local ID a v2 (DCE Security) UUID holds, stored in clock_seq_low.
+// See http://pubs.opengroup.org/onlinepubs/9629399/apdxa.htm
+type Domain byte
+
+const (
+	DomainPerson Domain = 0 // a POSIX UID
+	DomainGroup  Domain = 1 // a POSIX GID
+	DomainOrg    Domain = 2 // an organization ID, site defined
+)
+
+func (d Domain) String() string {
+	switch d {
+	case DomainPerson:
+		return "Person"
+	case DomainGroup:
+		return "Group"
+	case DomainOrg:
+		return "Org"
+	}
+
+	return fmt.Sprintf("Domain(%d)", byte(d))
+}
+
+// NewV2 creates a DCE Security UUID: a v1 whose time_low is replaced by id and whose clock_seq_low
+// is replaced by domain. That leaves a timestamp that ticks every 429 seconds and 6 bits of clock
+// sequence, so only 64 UUIDs for the same domain and id can be made in that time before they repeat.
+// A clock sequence range cuts that down to the values of the 6 bits inside it; a range of 256 or
+// less, as EnableHostLock hands out, has one and NewV2 returns ErrDCESeqRange
+func (g *Generator) NewV2(domain Domain, id uint32) (UUID, error) {
+
+	uuid, err := g.newTime(&uuidTime{now: g.now}, 2, insertTimestamp, false)
+
+	if err != nil {
+		return uuid, err
+	}
+
+	binary.BigEndian.PutUint32(uuid[0:], id)
+	uuid[9] = byte(domain)
+
+	return uuid, nil
+}
+
+// NewDCEPerson is NewV2 in the Person domain with the UID of the current user.
+// Platforms without numeric UIDs (such as the SIDs of Windows) return the error
+func (g *Generator) NewDCEPerson() (UUID, error) {
+
+	us, err := user.Current()
+
+	if err != nil {
+		return UUID{}, err
+	}
+
+	return g.newDCE(DomainPerson, us.Uid)
+}
+
+// NewDCEGroup is NewDCEPerson with the primary GID of the current user in the Group domain
+func (g *Generator) NewDCEGroup() (UUID, error) {
+
+	us, err := user.Current()
+
+	if err != nil {
+		return UUID{}, err
+	}
+
+	return g.newDCE(DomainGroup, us.Gid)
+}
+
+func (g *Generator) newDCE(domain Domain, id string) (UUID, error) {
+
+	i, err := strconv.ParseUint(id, 10, 32)
+
+	if err != nil {
+		return UUID{}, err
+	}
+
+	return g.NewV2(domain, uint32(i))
+}
+
+// Domain returns the domain of a v2 UUID, the meaning of ID
+func (u UUID) Domain() Domain {
+	return Domain(u[9])
+}
+
+// ID returns the local ID (UID, GID...) of a v2 UUID, time_low for other versions
+func (u UUID) ID() uint32 {
+	return binary.BigEndian.Uint32(u[0:])
+}
+
+// nextDCESeq advances the 6 bits of the clock sequence cs that a v2 keeps (bits 8-13),
+// wrapping inside the range starting at base. A span of 0 is the whole clock sequence
+func nextDCESeq(cs, base, span uint16) (uint16, error) {
+
+	if span == 0 {
+		return cs + dceSeqStep, nil
+	}
+
+	first, last := base/dceSeqStep, (base+span-1)/dceSeqStep
+
+	if first == last {
+		return cs, ErrDCESeqRange
+	}
+
+	high := cs/dceSeqStep + 1
+
+	if high > last || high < first {
+		high = first
+	}
+
+	// the ends of the range may only hold part of a 256 block
+	return min(max(high*dceSeqStep|cs%dceSeqStep, base), base+span-1), nil
+}
@@ -0,0 +1,117 @@
+package uuid
+
+import (
+	"os/user"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestNewV2(t *testing.T) {
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	g := NewGenerator(WithClock(FixedClock(now)))
+	seen := make(map[UUID]bool)
+
+	for i := 0; i < 64; i++ {
+		uuid, err := g.NewV2(DomainGroup, 1000)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if uuid.Version() != 2 || uuid.Variant() != VariantRFC4122 {
+			t.Fatal("not a v2:", uuid)
+		}
+
+		if uuid.Domain() != DomainGroup || uuid.ID() != 1000 {
+			t.Error("domain and id should be:", DomainGroup, 1000, "got:", uuid.Domain(), uuid.ID())
+		}
+
+		if ts, _ := uuid.Time(); ts.After(now) || now.Sub(ts) > 430*time.Second {
+			t.Error("time should be within 429s before:", now, "got:", ts)
+		}
+
+		if seen[uuid] {
+			t.Error("the 6 bit clock sequence should give 64 distinct UUIDs, repeated after:", i)
+		}
+
+		seen[uuid] = true
+	}
+
+	uuid, _ := g.NewV2(DomainOrg, 0xDEADBEEF)
+
+	if s := uuid.String(); s[:8] != "deadbeef" || s[21:23] != "02" {
+		t.Error("time_low should be the id and clock_seq_low the domain, got:", s)
+	}
+}
+
+func TestNewV2Range(t *testing.T) {
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	node := [6]byte{0x02, 0, 0, 0, 0, 1}
+
+	g := NewGenerator(WithClock(FixedClock(now)), WithNodeRotation([]NodeSlot{{Node: node, ClockSeqBase: 512, ClockSeqSpan: 256}}))
+
+	if _, err := g.NewV2(DomainPerson, 1000); err != ErrDCESeqRange {
+		t.Error("v2 in a 256 wide range should be:", ErrDCESeqRange, "got:", err)
+	}
+
+	// 1000 to 2999 covers the 6 bit values 3 to 11
+	g = NewGenerator(WithClock(FixedClock(now)), WithNodeRotation([]NodeSlot{{Node: node, ClockSeqBase: 1000, ClockSeqSpan: 2000}}))
+	seen := make(map[UUID]bool)
+
+	for i := 0; i < 9; i++ {
+		uuid, err := g.NewV2(DomainPerson, 1000)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if cs := uuid.ClockSequence() >> 8; cs < 3 || cs > 11 {
+			t.Error("the 6 bits should stay in 3-11, got:", cs)
+		}
+
+		if seen[uuid] {
+			t.Error("the range should give 9 distinct v2 UUIDs, repeated after:", i)
+		}
+
+		seen[uuid] = true
+	}
+}
+
+func TestNewDCEPerson(t *testing.T) {
+
+	us, err := user.Current()
+
+	if err != nil {
+		t.Skip(err)
+	}
+
+	uid, err := strconv.ParseUint(us.Uid, 10, 32)
+
+	if err != nil {
+		t.Skip("no numeric UID:", us.Uid)
+	}
+
+	gid, _ := strconv.ParseUint(us.Gid, 10, 32)
+
+	if uuid := NewDCEPerson(); uuid.Domain() != DomainPerson || uuid.ID() != uint32(uid) {
+		t.Error("person should be:", uid, "got:", uuid.Domain(), uuid.ID())
+	}
+
+	if uuid := NewDCEGroup(); uuid.Domain() != DomainGroup || uuid.ID() != uint32(gid) {
+		t.Error("group should be:", gid, "got:", uuid.Domain(), uuid.ID())
+	}
+}
+
+func TestDomainString(t *testing.T) {
+
+	tests := map[Domain]string{DomainPerson: "Person", DomainGroup: "Group", DomainOrg: "Org", 7: "Domain(7)"}
+
+	for d, want := range tests {
+		if d.String() != want {
+			t.Error("domain should be:", want, "got:", d.String())
+		}
+	}
+}
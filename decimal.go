@@ -0,0 +1,75 @@
+package uuid
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// decimalSize is the number of digits of 2^128-1, the largest UUID
+const decimalSize = 39
+
+// DecimalString renders the UUID as an unsigned 128 bit integer in 39 zero padded decimal digits,
+// for systems (ERP, EDI) that cannot carry hex or dashes
+func (u UUID) DecimalString() string {
+	return fmt.Sprintf("%039s", new(big.Int).SetBytes(u[:]).String())
+}
+
+// FromDecimalString parses the form written by DecimalString. Only the digits 0-9 are allowed
+// and shorter inputs are treated as if they were zero padded
+func FromDecimalString(s string) (UUID, error) {
+
+	var uuid UUID
+
+	if len(s) == 0 || len(s) > decimalSize {
+		return uuid, ErrUUIDFormat
+	}
+
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return uuid, ErrUUIDFormat
+		}
+	}
+
+	n, ok := new(big.Int).SetString(s, 10)
+
+	if !ok || n.BitLen() > uuidSize*8 {
+		return uuid, ErrUUIDFormat
+	}
+
+	n.FillBytes(uuid[:])
+
+	return FromBytes(uuid[:])
+}
+
+// DecimalSegments splits DecimalString into segments of n digits for fields with a length limit.
+// The last segment holds the remainder when n does not divide 39; n < 1 returns a single segment
+func (u UUID) DecimalSegments(n int) []string {
+
+	s := u.DecimalString()
+
+	if n < 1 {
+		n = decimalSize
+	}
+
+	segs := make([]string, 0, (decimalSize+n-1)/n)
+
+	for len(s) > n {
+		segs = append(segs, s[:n])
+		s = s[n:]
+	}
+
+	return append(segs, s)
+}
+
+// FromDecimalSegments reassembles the segments written by DecimalSegments
+func FromDecimalSegments(segs []string) (UUID, error) {
+
+	s := strings.Join(segs, "")
+
+	if len(s) != decimalSize {
+		return UUID{}, ErrUUIDFormat
+	}
+
+	return FromDecimalString(s)
+}
@@ -0,0 +1,65 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestDecimalString(t *testing.T) {
+
+	uuid, _ := FromString("00000000-0000-1000-8000-000000000001")
+	want := "000000000000000075567087097951178194945"
+
+	if uuid.DecimalString() != want {
+		t.Error("DecimalString is", uuid.DecimalString(), "should be:", want)
+	}
+
+	for i := 0; i < 1000; i++ {
+		uuid = NewV4()
+		s := uuid.DecimalString()
+
+		if len(s) != decimalSize {
+			t.Fatal("DecimalString is", len(s), "digits")
+		}
+
+		back, err := FromDecimalString(s)
+
+		if err != nil || back != uuid {
+			t.Fatal("FromDecimalString did not round trip", s, err)
+		}
+	}
+
+	bad := []string{"", "12a", "340282366920938463463374607431768211456", "-1", "0000000000000000000000000000000000000001"}
+
+	for _, s := range bad {
+		if _, err := FromDecimalString(s); err != ErrUUIDFormat {
+			t.Error("FromDecimalString did not reject", s, err)
+		}
+	}
+}
+
+func TestDecimalSegments(t *testing.T) {
+
+	uuid := NewV4()
+
+	for _, n := range []int{0, 1, 5, 13, 35, 39, 50} {
+		segs := uuid.DecimalSegments(n)
+
+		for i, seg := range segs {
+			if n > 0 && len(seg) > n || i < len(segs)-1 && len(seg) != n {
+				t.Error("DecimalSegments", n, "has a segment of", len(seg))
+			}
+		}
+
+		back, err := FromDecimalSegments(segs)
+
+		if err != nil || back != uuid {
+			t.Error("FromDecimalSegments did not round trip", segs, err)
+		}
+	}
+
+	segs := uuid.DecimalSegments(13)
+
+	if _, err := FromDecimalSegments(segs[:2]); err != ErrUUIDFormat {
+		t.Error("FromDecimalSegments did not detect missing segment")
+	}
+}
@@ -0,0 +1,107 @@
+package uuid
+
+import (
+	"fmt"
+)
+
+// Dialect is the SQL flavour used by the DDL and query helpers
+type Dialect int
+
+const (
+	Postgres Dialect = iota
+	MySQL
+	SQLite
+	SQLServer
+)
+
+// ColumnHint is the recommended way to store this package's UUIDs in a dialect
+type ColumnHint struct {
+	Type    string // column type
+	Default string // default expression, empty when the database cannot generate one
+	Comment string // explains the choice, for the DDL
+}
+
+// ColumnHintFor returns the recommended column type and default for d.
+// Unknown dialects get a CHAR(36) column which every database can hold
+func ColumnHintFor(d Dialect) ColumnHint {
+
+	ordered := "Time ordered UUIDs generated by the application keep inserts at the end of the index; " +
+		"the default only covers rows inserted by hand."
+
+	switch d {
+	case Postgres:
+		return ColumnHint{"uuid", "gen_random_uuid()", "Native 16 byte uuid type. " + ordered}
+	case MySQL:
+		return ColumnHint{"BINARY(16)", "(UUID_TO_BIN(UUID()))", "Raw 16 bytes in RFC4122 byte order, half the size of CHAR(36). " + ordered}
+	case SQLite:
+		return ColumnHint{"BLOB", "", "Raw 16 bytes; SQLite cannot generate UUIDs so the application must. " + ordered}
+	case SQLServer:
+		return ColumnHint{"UNIQUEIDENTIFIER", "NEWID()", "UNIQUEIDENTIFIER sorts by its last 6 bytes first, so time ordered UUIDs do not keep their order in SQL Server indexes."}
+	}
+
+	return ColumnHint{"CHAR(36)", "", "Canonical 8-4-4-4-12 text"}
+}
+
+// ColumnDDL returns the column definition for name in d with the hint's comment above it, e.g.
+//
+//	-- Native 16 byte uuid type. ...
+//	id uuid DEFAULT gen_random_uuid()
+func ColumnDDL(d Dialect, name string) string {
+
+	h := ColumnHintFor(d)
+	col := fmt.Sprintf("-- %s\n%s %s", h.Comment, name, h.Type)
+
+	if h.Default != "" {
+		col += " DEFAULT " + h.Default
+	}
+
+	return col
+}
+
+// InClause builds the parenthesised placeholder list for an IN over ids, with args typed for the
+// column ColumnHintFor recommends: lowercase strings for uuid, UNIQUEIDENTIFIER and CHAR(36), raw bytes for
+// BINARY(16) and BLOB. Placeholders are $n for Postgres, @pn for SQL Server and ? otherwise.
+// No ids gives (NULL), which matches no rows but is still valid SQL
+func (d Dialect) InClause(ids []UUID) (string, []interface{}) {
+
+	if len(ids) == 0 {
+		return "(NULL)", nil
+	}
+
+	b := make([]byte, 0, len(ids)*4+2)
+	args := make([]interface{}, len(ids))
+
+	b = append(b, '(')
+
+	for i, id := range ids {
+		if i > 0 {
+			b = append(b, ',')
+		}
+
+		b = append(b, d.placeholder(i+1)...)
+
+		switch d {
+		case MySQL, SQLite:
+			args[i] = append([]byte(nil), id[:]...)
+		default:
+			args[i] = id.canonical()
+		}
+	}
+
+	b = append(b, ')')
+
+	return string(b), args
+}
+
+// placeholder is the n'th (from 1) bind parameter of d
+func (d Dialect) placeholder(n int) string {
+
+	switch d {
+	case Postgres:
+		return fmt.Sprintf("$%d", n)
+	case SQLServer:
+		return fmt.Sprintf("@p%d", n)
+	}
+
+	return "?"
+}
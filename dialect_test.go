@@ -0,0 +1,63 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestColumnDDL(t *testing.T) {
+
+	tests := []struct {
+		d    Dialect
+		want string
+	}{
+		{Postgres, "id uuid DEFAULT gen_random_uuid()"},
+		{MySQL, "id BINARY(16) DEFAULT (UUID_TO_BIN(UUID()))"},
+		{SQLite, "id BLOB"},
+		{SQLServer, "id UNIQUEIDENTIFIER DEFAULT NEWID()"},
+		{Dialect(99), "id CHAR(36)"},
+	}
+
+	for _, test := range tests {
+		ddl := ColumnDDL(test.d, "id")
+		h := ColumnHintFor(test.d)
+
+		if ddl != "-- "+h.Comment+"\n"+test.want {
+			t.Error("ColumnDDL is", ddl, "should end with:", test.want)
+		}
+	}
+}
+
+func TestInClause(t *testing.T) {
+
+	ids := []UUID{DNSNamespace, URLNamespace}
+
+	tests := []struct {
+		d    Dialect
+		want string
+	}{
+		{Postgres, "($1,$2)"},
+		{MySQL, "(?,?)"},
+		{SQLite, "(?,?)"},
+		{SQLServer, "(@p1,@p2)"},
+	}
+
+	for _, test := range tests {
+		clause, args := test.d.InClause(ids)
+
+		if clause != test.want || len(args) != 2 {
+			t.Error("InClause is", clause, "should be:", test.want)
+		}
+	}
+
+	if _, args := Postgres.InClause(ids); args[0] != DNSNamespace.String() {
+		t.Error("Postgres InClause args should be strings", args[0])
+	}
+
+	if _, args := MySQL.InClause(ids); len(args[1].([]byte)) != uuidSize {
+		t.Error("MySQL InClause args should be 16 bytes", args[1])
+	}
+
+	if clause, args := Postgres.InClause(nil); clause != "(NULL)" || args != nil {
+		t.Error("InClause with no ids is", clause)
+	}
+}
@@ -0,0 +1,30 @@
+package uuid
+
+// Content addressed UUIDs: the digest is truncated to its first 16 bytes and then tagged
+// as version 8 (https://www.rfc-editor.org/rfc/rfc9562#section-5.8) with the RFC4122 variant.
+// Setting those bits overwrites 6 bits of the digest, leaving 122 bits of it in the UUID,
+// so the digest cannot be recovered from the UUID
+
+// FromSHA256Digest converts a SHA-256 digest into a v8 UUID
+func FromSHA256Digest(d [32]byte) UUID {
+	return fromDigest(d[:])
+}
+
+// FromSHA1Digest converts a SHA-1 digest into a v8 UUID
+func FromSHA1Digest(d [20]byte) UUID {
+	return fromDigest(d[:])
+}
+
+// FromMD5Digest converts a MD5 digest into a v8 UUID
+func FromMD5Digest(d [16]byte) UUID {
+	return fromDigest(d[:])
+}
+
+func fromDigest(d []byte) UUID {
+
+	var data [16]byte
+
+	copy(data[:], d)
+
+	return NewV8(data)
+}
@@ -0,0 +1,35 @@
+package uuid
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"testing"
+)
+
+func TestFromDigest(t *testing.T) {
+
+	data := []byte("content")
+
+	uuids := []UUID{
+		FromSHA256Digest(sha256.Sum256(data)),
+		FromSHA1Digest(sha1.Sum(data)),
+		FromMD5Digest(md5.Sum(data)),
+	}
+
+	for _, uuid := range uuids {
+		if uuid[6]>>4 != 8 || uuid[8]&0xC0 != 0x80 {
+			t.Error("digest UUID is not v8 RFC4122", uuid.String())
+		}
+
+		if _, err := FromString(uuid.String()); err != nil {
+			t.Error("digest UUID does not parse", uuid.String(), err)
+		}
+	}
+
+	sum := sha256.Sum256(data)
+
+	if FromSHA256Digest(sum) != uuids[0] {
+		t.Error("FromSHA256Digest is not deterministic")
+	}
+}
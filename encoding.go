@@ -0,0 +1,96 @@
+package uuid
+
+// MarshalText implements encoding.TextMarshaler, so UUIDs are strings in JSON, TOML, YAML, etc.
+// The text is String, in the OutputCase set by Configure
+func (u UUID) MarshalText() ([]byte, error) {
+	return u.AppendText(make([]byte, 0, uuidStringSize))
+}
+
+// AppendText implements encoding.TextAppender: it appends String to b without allocating
+// when b has room for 36 more bytes
+func (u UUID) AppendText(b []byte) ([]byte, error) {
+
+	if err := checkOutput(u); err != nil {
+		return b, err
+	}
+
+	var buf [uuidStringSize]byte
+	encodeString(&buf, &u, currentConfig().OutputCase)
+
+	return append(b, buf[:]...), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler. It accepts what FromString accepts,
+// which includes the braced ({...}) and URN (urn:uuid:...) forms, and the 22 character Base64
+// form of Compact unless the Config has StrictParse. With StrictParse only the 8-4-4-4-12 form
+// is accepted, but in either case, so what MarshalText writes in UpperCase reads back
+func (u *UUID) UnmarshalText(text []byte) error {
+
+	strict := currentConfig().StrictParse
+
+	if len(text) == compactSize && !strict {
+		uuid, err := FromBase64(string(text))
+
+		if err != nil {
+			return err
+		}
+
+		*u = uuid
+
+		return nil
+	}
+
+	uuid, err := parse(string(text))
+
+	if err != nil {
+		return err
+	}
+
+	if strict && !canonicalShape(string(text), true) {
+		return ErrUUIDFormat
+	}
+
+	*u = uuid
+
+	return nil
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler with the raw 16 bytes in RFC4122 (big endian) order
+func (u UUID) MarshalBinary() ([]byte, error) {
+	return u.AppendBinary(make([]byte, 0, uuidSize))
+}
+
+// AppendBinary implements encoding.BinaryAppender, appending the bytes MarshalBinary returns
+func (u UUID) AppendBinary(b []byte) ([]byte, error) {
+
+	if err := checkOutput(u); err != nil {
+		return b, err
+	}
+
+	return append(b, u[:]...), nil
+}
+
+// checkOutput is the Config.StrictOutput check of the encodings, the counterpart of
+// WithStrictOutput for UUIDs that did not come from a strict Generator. Nil and Max pass
+func checkOutput(u UUID) error {
+
+	if currentConfig().StrictOutput && u != Nil && u != Max && !consistent(u) {
+		return ErrInconsistent
+	}
+
+	return nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler, accepting what FromBytes accepts
+func (u *UUID) UnmarshalBinary(data []byte) error {
+
+	uuid, err := FromBytes(data)
+
+	if err != nil {
+		return err
+	}
+
+	*u = uuid
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"flag"
+	"testing"
+)
+
+func TestTextMarshaler(t *testing.T) {
+
+	var uuid UUID
+
+	tests := []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"URN:UUID:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	}
+
+	for _, test := range tests {
+		if err := uuid.UnmarshalText([]byte(test)); err != nil || uuid != DNSNamespace {
+			t.Error("UnmarshalText failed on", test, err)
+		}
+	}
+
+	bad := []string{"", "{6ba7b810-9dad-11d1-80b4-00c04fd430c8", "urn:uuid:", "urn:6ba7b810-9dad-11d1-80b4-00c04fd430c8"}
+
+	for _, test := range bad {
+		if err := uuid.UnmarshalText([]byte(test)); err == nil {
+			t.Error("UnmarshalText accepted", test)
+		}
+	}
+}
+
+func TestTextMarshalerJSON(t *testing.T) {
+
+	type row struct {
+		ID  UUID
+		Ptr *UUID
+	}
+
+	in := row{ID: NewV4(), Ptr: &URLNamespace}
+	b, err := json.Marshal(in)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"ID":"` + in.ID.String() + `","Ptr":"6ba7b811-9dad-11d1-80b4-00c04fd430c8"}`
+
+	if string(b) != want {
+		t.Error("json is", string(b), "should be:", want)
+	}
+
+	var out row
+
+	if err = json.Unmarshal(b, &out); err != nil || out.ID != in.ID || *out.Ptr != URLNamespace {
+		t.Error("json did not round trip", err)
+	}
+}
+
+func TestTextMarshalerFlag(t *testing.T) {
+
+	var uuid UUID
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.TextVar(&uuid, "id", UUID{}, "id")
+
+	if err := fs.Parse([]string{"-id", "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"}); err != nil || uuid != DNSNamespace {
+		t.Error("flag did not parse", err)
+	}
+}
+
+func TestBinaryMarshaler(t *testing.T) {
+
+	uuid := NewV4()
+	b, _ := uuid.MarshalBinary()
+
+	if !bytes.Equal(b, uuid[:]) {
+		t.Error("MarshalBinary is not the raw bytes", b)
+	}
+
+	var back UUID
+
+	if err := back.UnmarshalBinary(b); err != nil || back != uuid {
+		t.Error("binary did not round trip", err)
+	}
+
+	if err := back.UnmarshalBinary(b[:8]); err != ErrUUIDSize {
+		t.Error("UnmarshalBinary did not detect wrong length", err)
+	}
+
+	type row struct{ ID UUID }
+
+	var buf bytes.Buffer
+
+	if err := gob.NewEncoder(&buf).Encode(row{uuid}); err != nil {
+		t.Fatal(err)
+	}
+
+	var out row
+
+	if err := gob.NewDecoder(&buf).Decode(&out); err != nil || out.ID != uuid {
+		t.Error("gob did not round trip", err)
+	}
+}
+
+// configCombinations are the OutputCase and StrictParse pairs the encodings must round trip under
+var configCombinations = []Config{
+	{},
+	{OutputCase: UpperCase},
+	{StrictParse: true},
+	{OutputCase: UpperCase, StrictParse: true},
+}
+
+func TestTextMarshalerConfigs(t *testing.T) {
+
+	defer Configure(Config{})
+
+	type row struct{ ID UUID }
+
+	for _, c := range configCombinations {
+		if err := Configure(c); err != nil {
+			t.Fatal(err)
+		}
+
+		in := row{NewV4()}
+		js, err := json.Marshal(in)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var out row
+
+		if err = json.Unmarshal(js, &out); err != nil || out != in {
+			t.Error("json did not round trip under", c, string(js), err)
+		}
+	}
+}
+
+func TestStrictOutputConfig(t *testing.T) {
+
+	defer Configure(Config{})
+
+	if err := Configure(Config{StrictOutput: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, uuid := range []UUID{NewV4(), NewV7(), NewV8([16]byte{}), Nil, Max} {
+		if _, err := uuid.MarshalText(); err != nil {
+			t.Error("MarshalText of", uuid.String(), "should pass, got:", err)
+		}
+
+		if _, err := uuid.MarshalBinary(); err != nil {
+			t.Error("MarshalBinary of", uuid.String(), "should pass, got:", err)
+		}
+
+		if _, err := uuid.Value(); err != nil {
+			t.Error("Value of", uuid.String(), "should pass, got:", err)
+		}
+	}
+
+	bad := NewV4()
+	bad[8] &= 0x3F // NCS variant
+
+	if _, err := bad.MarshalText(); err != ErrInconsistent {
+		t.Error("MarshalText should be:", ErrInconsistent, "got:", err)
+	}
+
+	if _, err := bad.MarshalBinary(); err != ErrInconsistent {
+		t.Error("MarshalBinary should be:", ErrInconsistent, "got:", err)
+	}
+
+	if _, err := bad.Value(); err != ErrInconsistent {
+		t.Error("Value should be:", ErrInconsistent, "got:", err)
+	}
+
+	if _, err := Compact(bad).MarshalText(); err != ErrInconsistent {
+		t.Error("Compact MarshalText should be:", ErrInconsistent, "got:", err)
+	}
+
+	if _, err := (VersionedID{UUID: bad}).MarshalText(); err != ErrInconsistent {
+		t.Error("VersionedID MarshalText should be:", ErrInconsistent, "got:", err)
+	}
+
+	if _, err := json.Marshal(struct{ ID UUID }{bad}); err == nil {
+		t.Error("json should not encode an inconsistent UUID")
+	}
+}
+
+func TestAppendText(t *testing.T) {
+
+	b := []byte("id=")
+	b, _ = DNSNamespace.AppendText(b)
+
+	if string(b) != "id=6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Error("AppendText should be:", "id=6ba7b810-9dad-11d1-80b4-00c04fd430c8", "got:", string(b))
+	}
+
+	b, _ = DNSNamespace.AppendBinary(b[:0])
+
+	if !bytes.Equal(b, DNSNamespace[:]) {
+		t.Error("AppendBinary should be:", DNSNamespace[:], "got:", b)
+	}
+
+	buf := make([]byte, 0, uuidStringSize)
+	uuid := NewV4()
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf, _ = uuid.AppendText(buf[:0])
+		buf, _ = uuid.AppendBinary(buf[:0])
+	})
+
+	if allocs != 0 {
+		t.Error("AppendText and AppendBinary should not allocate, got:", allocs)
+	}
+
+	if allocs = testing.AllocsPerRun(100, func() { _ = uuid.String() }); allocs > 1 {
+		t.Error("String should allocate once, got:", allocs)
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+
+	uuid := NewV4()
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		devNull(uuid.String())
+	}
+}
+
+func BenchmarkAppendText(b *testing.B) {
+
+	uuid := NewV4()
+	buf := make([]byte, 0, uuidStringSize)
+	b.ReportAllocs()
+
+	for n := 0; n < b.N; n++ {
+		buf, _ = uuid.AppendText(buf[:0])
+	}
+}
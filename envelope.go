@@ -0,0 +1,80 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+)
+
+const (
+	envelopeMagic   = 'U'  // first byte of every envelope
+	envelopeVersion = 0x01 // format version, low nibble of the second byte
+	envelopeCRCFlag = 0x80 // set in the second byte when a CRC follows the UUID
+
+	envelopeSize    = 2 + uuidSize
+	envelopeCRCSize = envelopeSize + 4
+)
+
+var (
+	crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+	// ErrEnvelope is returned when bytes are not a UUID envelope this version understands
+	ErrEnvelope = errors.New("not a UUID envelope")
+)
+
+// MarshalEnvelope returns the UUID in a small self describing binary form for protocols where
+// raw 16 bytes could be mistaken for another fixed width field:
+//
+//	byte 0       'U' (0x55)
+//	byte 1       format version (1) in the low nibble, 0x80 set when a CRC follows
+//	bytes 2-17   the UUID
+//	bytes 18-21  CRC-32C (Castagnoli) of bytes 0-17, big endian, only when withCRC
+func (u UUID) MarshalEnvelope(withCRC bool) []byte {
+
+	b := make([]byte, envelopeSize, envelopeCRCSize)
+
+	b[0] = envelopeMagic
+	b[1] = envelopeVersion
+	copy(b[2:], u[:])
+
+	if withCRC {
+		b[1] |= envelopeCRCFlag
+		b = binary.BigEndian.AppendUint32(b, crc32.Checksum(b, crcTable))
+	}
+
+	return b
+}
+
+// UnmarshalEnvelope reads an envelope from the start of b and returns the UUID and the number
+// of bytes it took (18 or 22), so it can be used in the middle of a larger message.
+// A CRC mismatch returns ErrChecksum, and a UUID FromBytes rejects returns its error
+func UnmarshalEnvelope(b []byte) (UUID, int, error) {
+
+	var uuid UUID
+
+	if len(b) < envelopeSize || b[0] != envelopeMagic || b[1]&^envelopeCRCFlag != envelopeVersion {
+		return uuid, 0, ErrEnvelope
+	}
+
+	n := envelopeSize
+
+	if b[1]&envelopeCRCFlag != 0 {
+		n = envelopeCRCSize
+
+		if len(b) < n {
+			return uuid, 0, ErrEnvelope
+		}
+
+		if binary.BigEndian.Uint32(b[envelopeSize:]) != crc32.Checksum(b[:envelopeSize], crcTable) {
+			return uuid, 0, ErrChecksum
+		}
+	}
+
+	uuid, err := FromBytes(b[2:envelopeSize])
+
+	if err != nil {
+		return UUID{}, 0, err
+	}
+
+	return uuid, n, nil
+}
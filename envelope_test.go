@@ -0,0 +1,51 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestEnvelope(t *testing.T) {
+
+	uuid := NewV4()
+
+	for _, withCRC := range []bool{false, true} {
+		b := uuid.MarshalEnvelope(withCRC)
+
+		// trailing bytes belong to the next field
+		back, n, err := UnmarshalEnvelope(append(b, 0xAA, 0xBB))
+
+		if err != nil || back != uuid || n != len(b) {
+			t.Error("envelope did not round trip", withCRC, n, err)
+		}
+	}
+
+	b := uuid.MarshalEnvelope(true)
+	b[5] ^= 0x01
+
+	if _, _, err := UnmarshalEnvelope(b); err != ErrChecksum {
+		t.Error("envelope CRC did not catch corruption", err)
+	}
+
+	b = uuid.MarshalEnvelope(false)
+
+	bad := [][]byte{
+		b[:10],
+		append([]byte{'X'}, b[1:]...),
+		append([]byte{'U', 0x02}, b[2:]...),
+		uuid.MarshalEnvelope(true)[:20],
+	}
+
+	for _, test := range bad {
+		if _, _, err := UnmarshalEnvelope(test); err != ErrEnvelope {
+			t.Error("UnmarshalEnvelope accepted", test, err)
+		}
+	}
+
+	// an RFC4122 variant with version 0 is not a UUID, even with a good CRC
+	var zero UUID
+	zero.variant(rfc4122)
+
+	if _, _, err := UnmarshalEnvelope(zero.MarshalEnvelope(true)); err != ErrUUIDFormat {
+		t.Error("UnmarshalEnvelope should be:", ErrUUIDFormat, "got:", err)
+	}
+}
@@ -0,0 +1,118 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Rule names a check Explain can report as violated
+type Rule string
+
+const (
+	RuleASCII   Rule = "ascii"   // a character is outside of ASCII
+	RuleLength  Rule = "length"  // not 36 characters (or 32 without dashes)
+	RuleDash    Rule = "dash"    // a dash is missing or misplaced
+	RuleHex     Rule = "hex"     // a character is not a hex digit
+	RuleVersion Rule = "version" // the version nibble is not 1-8
+	RuleVariant Rule = "variant" // the variant is not RFC4122 (8, 9, a or b)
+)
+
+// Violation is one broken rule. Index is the position in the input, -1 when it is about the whole input
+type Violation struct {
+	Rule   Rule   `json:"rule"`
+	Index  int    `json:"index"`
+	Detail string `json:"detail"`
+}
+
+// Report is the result of Explain
+type Report struct {
+	Input      string      `json:"input"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// OK reports whether the input broke no rule
+func (r Report) OK() bool {
+	return len(r.Violations) == 0
+}
+
+func (r Report) String() string {
+
+	if r.OK() {
+		return fmt.Sprintf("%q is a valid UUID", r.Input)
+	}
+
+	msgs := make([]string, len(r.Violations))
+
+	for i, v := range r.Violations {
+		msgs[i] = v.Detail
+	}
+
+	return fmt.Sprintf("%q is not a valid UUID: %s", r.Input, strings.Join(msgs, "; "))
+}
+
+// Explain lists every rule s breaks as 8-4-4-4-12 (or 32 digit) hex with a supported version
+// and the RFC4122 variant, instead of stopping at the first like ParseStrict.
+// FromString is less picky: it accepts the other variants whatever their version
+// Explain does not look at the Config, so it does not report StrictParse rules
+func Explain(s string) Report {
+
+	r := Report{Input: s}
+
+	add := func(rule Rule, i int, format string, args ...interface{}) {
+		r.Violations = append(r.Violations, Violation{Rule: rule, Index: i, Detail: fmt.Sprintf(format, args...)})
+	}
+
+	// check dash positions of the 8-4-4-4-12 form unless it is 32 digits
+	dashed := len(s) != hex32
+
+	if len(s) != uuidStringSize && len(s) != hex32 {
+		add(RuleLength, -1, "length is %d, should be %d (or %d without dashes)", len(s), uuidStringSize, hex32)
+	}
+
+	// positions of the version and variant digits, only known for the two lengths
+	version, variant := -1, -1
+
+	switch len(s) {
+	case uuidStringSize:
+		version, variant = 14, 19
+	case hex32:
+		version, variant = 12, 16
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		if c >= 0x80 {
+			add(RuleASCII, i, "byte %d (0x%02x) is not ASCII", i, c)
+			continue
+		}
+
+		dash := dashed && (i == 8 || i == 13 || i == 18 || i == 23)
+
+		switch {
+		case dash && c != '-':
+			add(RuleDash, i, "character %d is %q, should be '-'", i, c)
+		case !dash && c == '-' && dashed:
+			add(RuleDash, i, "character %d is a misplaced '-'", i)
+		case !dash && c == '-':
+			add(RuleDash, i, "character %d is a '-' in the 32 digit form", i)
+		case !dash && !isHex(c):
+			add(RuleHex, i, "character %d is %q, should be a hex digit", i, c)
+		}
+	}
+
+	// Nil and Max have no version or variant
+	if version >= 0 && (strings.Trim(s, "0-") == "" || strings.Trim(s, "fF-") == "") {
+		return r
+	}
+
+	if version >= 0 && !strings.ContainsRune("12345678", rune(s[version])) {
+		add(RuleVersion, version, "version is %q, should be 1-8", s[version])
+	}
+
+	if variant >= 0 && !strings.ContainsRune("89abAB", rune(s[variant])) {
+		add(RuleVariant, variant, "variant digit is %q, should be 8, 9, a or b", s[variant])
+	}
+
+	return r
+}
@@ -0,0 +1,46 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+
+	tests := []struct {
+		s     string
+		rules []Rule
+	}{
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", nil},
+		{"6ba7b8109dad11d180b400c04fd430c8", nil},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c", []Rule{RuleLength}},
+		{"6ba7b810-9dad-91d1-30b4-00c04fd430c8", []Rule{RuleVersion, RuleVariant}},
+		{"6ba7b810_9dad-11d1-80b4-00c04fd430cz", []Rule{RuleDash, RuleHex}},
+		{"6ba7b8109-dad-11d1-80b4-00c04fd430c8", []Rule{RuleDash, RuleDash}},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c８", []Rule{RuleLength, RuleASCII, RuleASCII, RuleASCII}},
+	}
+
+	for _, test := range tests {
+		r := Explain(test.s)
+
+		if len(r.Violations) != len(test.rules) {
+			t.Error("Explain", test.s, "is", r)
+			continue
+		}
+
+		for i, v := range r.Violations {
+			if v.Rule != test.rules[i] {
+				t.Error("Explain", test.s, "violation", i, "is", v.Rule, "should be:", test.rules[i])
+			}
+		}
+
+		if r.OK() != (test.rules == nil) {
+			t.Error("Explain", test.s, "OK is wrong")
+		}
+	}
+
+	r := Explain("6ba7b810-9dad-11d1-80b4-00c04fd430cz")
+
+	if r.Violations[0].Index != 35 {
+		t.Error("Explain hex violation index is", r.Violations[0].Index)
+	}
+}
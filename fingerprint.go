@@ -0,0 +1,125 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"strings"
+)
+
+// FingerprintFile returns the content addressed UUID of the file at path: the SHA-256 of ns
+// followed by the file's bytes, as a v8 (see FromSHA256Digest). This is the name based v8 of
+// https://www.rfc-editor.org/rfc/rfc9562#appendix-B.2 with the contents as the name, so the same
+// bytes under the same namespace give the same UUID whatever the file is called or where it is.
+// The file is streamed, never read into memory whole
+func FingerprintFile(ns UUID, path string) (UUID, error) {
+
+	f, err := os.Open(path)
+
+	if err != nil {
+		return UUID{}, err
+	}
+
+	defer f.Close()
+
+	return FingerprintReader(ns, f)
+}
+
+// FingerprintReader is FingerprintFile for the bytes read from r until io.EOF
+func FingerprintReader(ns UUID, r io.Reader) (UUID, error) {
+
+	h := sha256.New()
+	h.Write(ns[:])
+
+	if _, err := io.Copy(h, r); err != nil {
+		return UUID{}, err
+	}
+
+	return FromSHA256Digest([32]byte(h.Sum(nil))), nil
+}
+
+// FingerprintTree returns one UUID for every regular file in fsys, for build systems that need
+// a stable identifier of an input set. Files are walked in lexical order and each contributes
+// its slash separated path and its FingerprintReader UUID, so renaming, adding, removing or editing
+// a file changes the result while the order files were written in, their modes and times do not.
+// Empty directories and files that are not regular (symlinks, devices...) are left out.
+// Paths matching one of the exclude patterns (see path.Match) are left out too, whole directories
+// included: a pattern with a slash is matched against the path from the root of fsys, one without
+// against each name, so "*.tmp" and ".git" work at any depth
+func FingerprintTree(ns UUID, fsys fs.FS, exclude ...string) (UUID, error) {
+
+	for _, pattern := range exclude {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return UUID{}, err
+		}
+	}
+
+	h := sha256.New()
+	h.Write(ns[:])
+
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+
+		if err != nil {
+			return err
+		}
+
+		if name != "." && excluded(name, exclude) {
+			if d.IsDir() {
+				return fs.SkipDir
+			}
+
+			return nil
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		f, err := fsys.Open(name)
+
+		if err != nil {
+			return err
+		}
+
+		defer f.Close()
+
+		uuid, err := FingerprintReader(ns, f)
+
+		if err != nil {
+			return err
+		}
+
+		// the NUL keeps a path from running into the next field, it cannot be in a name
+		h.Write([]byte(name))
+		h.Write([]byte{0})
+		h.Write(uuid[:])
+
+		return nil
+	})
+
+	if err != nil {
+		return UUID{}, err
+	}
+
+	return FromSHA256Digest([32]byte(h.Sum(nil))), nil
+}
+
+// excluded reports whether one of the patterns, already checked by FingerprintTree, matches name
+func excluded(name string, patterns []string) bool {
+
+	for _, pattern := range patterns {
+		target := name
+
+		if !strings.Contains(pattern, "/") {
+			target = path.Base(name)
+		}
+
+		if ok, _ := path.Match(pattern, target); ok {
+			return true
+		}
+	}
+
+	return false
+}
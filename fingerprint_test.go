@@ -0,0 +1,125 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"testing/iotest"
+	"time"
+)
+
+func TestFingerprintFile(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "asset.bin")
+	content := strings.Repeat("content addressed ", 10000)
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	uuid, err := FingerprintFile(NamespaceURL, path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := FromSHA256Digest(sha256.Sum256(append(NamespaceURL[:], content...)))
+
+	if uuid != want {
+		t.Error("fingerprint should be:", want, "got:", uuid)
+	}
+
+	if uuid.Version() != 8 || uuid.Variant() != VariantRFC4122 {
+		t.Error("fingerprint should be a v8, got:", uuid)
+	}
+
+	if other, _ := FingerprintFile(NamespaceDNS, path); other == uuid {
+		t.Error("namespaces should give different fingerprints")
+	}
+
+	if _, err = FingerprintFile(NamespaceURL, filepath.Join(t.TempDir(), "missing")); !errors.Is(err, os.ErrNotExist) {
+		t.Error("missing file should be:", os.ErrNotExist, "got:", err)
+	}
+}
+
+func TestFingerprintReader(t *testing.T) {
+
+	// RFC 9562 Appendix B.2: the SHA-256 v8 of www.example.com in the DNS namespace
+	uuid, err := FingerprintReader(NamespaceDNS, iotest.OneByteReader(strings.NewReader("www.example.com")))
+
+	if err != nil || uuid.String() != "5c146b14-3c52-8afd-938a-375d0df1fbf6" {
+		t.Error("fingerprint should be:", "5c146b14-3c52-8afd-938a-375d0df1fbf6", "got:", uuid, err)
+	}
+
+	if _, err = FingerprintReader(NamespaceDNS, iotest.ErrReader(iotest.ErrTimeout)); err != iotest.ErrTimeout {
+		t.Error("read error should be:", iotest.ErrTimeout, "got:", err)
+	}
+}
+
+func TestFingerprintTree(t *testing.T) {
+
+	tree := fstest.MapFS{
+		"main.go":          {Data: []byte("package main")},
+		"lib/lib.go":       {Data: []byte("package lib")},
+		"lib/lib.go.tmp":   {Data: []byte("editor backup")},
+		".git/HEAD":        {Data: []byte("ref: refs/heads/main")},
+		"vendor/x/x.go":    {Data: []byte("package x")},
+		"empty":            {Mode: fs.ModeDir},
+		"link":             {Data: []byte("main.go"), Mode: fs.ModeSymlink},
+		"assets/logo.png":  {Data: []byte{0x89, 'P', 'N', 'G'}, ModTime: time.Now()},
+		"assets/style.css": {Data: []byte("body {}")},
+	}
+
+	exclude := []string{"*.tmp", ".git", "vendor/*"}
+	uuid, err := FingerprintTree(NamespaceURL, tree, exclude...)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if uuid.Version() != 8 {
+		t.Error("tree fingerprint should be a v8, got:", uuid)
+	}
+
+	// what is excluded, empty or not a regular file does not count
+	same := fstest.MapFS{}
+
+	for _, name := range []string{"main.go", "lib/lib.go", "assets/logo.png", "assets/style.css"} {
+		same[name] = &fstest.MapFile{Data: tree[name].Data}
+	}
+
+	if other, _ := FingerprintTree(NamespaceURL, same); other != uuid {
+		t.Error("fingerprint should be:", uuid, "got:", other)
+	}
+
+	changes := map[string]func(fstest.MapFS){
+		"edit":   func(m fstest.MapFS) { m["main.go"] = &fstest.MapFile{Data: []byte("package other")} },
+		"add":    func(m fstest.MapFS) { m["lib/new.go"] = &fstest.MapFile{Data: []byte("package lib")} },
+		"remove": func(m fstest.MapFS) { delete(m, "assets/style.css") },
+		"rename": func(m fstest.MapFS) { m["assets/site.css"] = m["assets/style.css"]; delete(m, "assets/style.css") },
+	}
+
+	for name, change := range changes {
+		changed := fstest.MapFS{}
+
+		for k, v := range same {
+			changed[k] = v
+		}
+
+		change(changed)
+
+		if other, _ := FingerprintTree(NamespaceURL, changed); other == uuid {
+			t.Error(name, "should change the fingerprint")
+		}
+	}
+
+	if _, err = FingerprintTree(NamespaceURL, tree, "[bad"); err != path.ErrBadPattern {
+		t.Error("bad pattern should be:", path.ErrBadPattern, "got:", err)
+	}
+}
@@ -0,0 +1,62 @@
+package uuid
+
+import "slices"
+
+// Fork returns a new Generator with g's options, for worker processes or goroutines started from
+// a configured template. The fork keeps the node ID (or rotation slots), clock, rand source, the
+// read only and strict settings and shares g's quota, but starts over with its own random clock
+// sequences, different from g's, and none of g's last timestamps or v7 counter, so the two never
+// have to share a lock.
+// A rand source set with WithRandSource is shared and must then be safe for concurrent use.
+// With WithEpochLeases the fork leases epochs of its own from the same coordinator. State stores
+// and sequence files belong to one Generator and are not copied, so the fork's v1 and v6 UUIDs
+// are only kept apart from g's by the clock sequence
+func (g *Generator) Fork() *Generator {
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.node() // resolved once here rather than by both
+
+	f := &Generator{
+		addr:         g.addr,
+		nodeSource:   g.nodeSource,
+		clockSeqBase: g.clockSeqBase,
+		clockSeqSpan: g.clockSeqSpan,
+		readOnly:     g.readOnly,
+		strict:       g.strict,
+		rand:         g.rand,
+		clock:        g.clock,
+		subTick:      g.subTick,
+		rotation:     slices.Clone(g.rotation),
+		quota:        g.quota,
+		leases:       g.leases, // the fork leases its own epochs
+	}
+
+	// drawn under g's lock, as the source may be g's
+	f.clockSeq = f.forkClockSeq(g.clockSeq, f.clockSeqBase, f.clockSeqSpan)
+
+	for i := range f.rotation {
+		s := &f.rotation[i]
+		s.clockSeq = f.forkClockSeq(s.clockSeq, s.ClockSeqBase, s.ClockSeqSpan)
+		s.lastTime, s.lastClock = 0, 0
+	}
+
+	return f
+}
+
+// forkClockSeq is a random clock sequence in the range that is not old
+func (g *Generator) forkClockSeq(old, base, span uint16) uint16 {
+
+	seq := g.randomClockSeq()
+
+	if span > 0 {
+		seq = base + seq%span
+	}
+
+	if seq&0x3FFF == old&0x3FFF {
+		seq = nextClockSeq(seq, base, span)
+	}
+
+	return seq
+}
@@ -0,0 +1,95 @@
+package uuid
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFork(t *testing.T) {
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	store := FileStateStore(filepath.Join(t.TempDir(), "state"))
+	g := NewGenerator(WithClock(FixedClock(now)), WithNodeID([6]byte{1, 2, 3, 4, 5, 6}), WithStrictOutput(), WithStateStore(store))
+	g.NewV1()
+
+	f := g.Fork()
+
+	if f.NodeID() != g.NodeID() || f.NodeSource() != NodeExplicit || !f.strict || f.clock != g.clock {
+		t.Error("fork should keep the options")
+	}
+
+	if f.store != nil {
+		t.Error("fork should not share the state store")
+	}
+
+	if f.clockSeq&0x3FFF == g.clockSeq&0x3FFF {
+		t.Error("fork should have its own clock sequence, both are:", f.clockSeq)
+	}
+
+	a, _ := g.NewV1()
+	b, _ := f.NewV1()
+
+	if a == b || a.ClockSequence() == b.ClockSequence() {
+		t.Error("fork and template should differ by clock sequence:", a, b)
+	}
+
+	// the fixed clock gives the fork a fresh start from the same time
+	if ts, _ := b.Time(); !ts.Equal(now) {
+		t.Error("fork should not carry on from the template's last time, got:", ts)
+	}
+
+	if _, err := NewGenerator(WithReadOnly()).Fork().NewV4(); err != ErrReadOnly {
+		t.Error("fork of a read only Generator should be read only, got:", err)
+	}
+}
+
+func TestForkRotation(t *testing.T) {
+
+	slots := []NodeSlot{{Node: [6]byte{1}, ClockSeqBase: 256, ClockSeqSpan: 256}, {Node: [6]byte{2}}}
+	g := NewGenerator(WithNodeRotation(slots))
+	f := g.Fork()
+
+	for i := range slots {
+		if f.rotation[i].clockSeq == g.rotation[i].clockSeq {
+			t.Error("slot", i, "should have its own clock sequence")
+		}
+	}
+
+	if seq := f.rotation[0].clockSeq; seq < 256 || seq >= 512 {
+		t.Error("slot clock sequence should stay in its range, got:", seq)
+	}
+
+	f.rotation[1].Node[0] = 9
+
+	if g.rotation[1].Node[0] != 2 {
+		t.Error("fork should not share the slots with the template")
+	}
+}
+
+func TestForkConcurrent(t *testing.T) {
+
+	g := NewGenerator()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < testSize/10; i++ {
+			g.NewV1()
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 100; i++ {
+			g.Fork().NewV1()
+		}
+	}()
+
+	wg.Wait()
+}
@@ -0,0 +1,77 @@
+package uuid
+
+import "fmt"
+
+// Format implements fmt.Formatter:
+//
+//	%s, %v  String, in the OutputCase set by Configure
+//	%+v     Braced
+//	%q      String in double quotes
+//	%x, %X  the 32 hex digits without dashes, lowercase or uppercase whatever the OutputCase
+//	%#v     Go syntax, uuid.MustParse("...")
+//
+// Width and the - flag pad as they do for strings, so UUIDs line up in tables
+func (u UUID) Format(f fmt.State, verb rune) {
+
+	var s string
+
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		fmt.Fprintf(f, "uuid.MustParse(%q)", u.canonical())
+		return
+	case verb == 'v' && f.Flag('+'):
+		s = u.Braced()
+	case verb == 'v', verb == 's', verb == 'q':
+		s = u.String()
+	case verb == 'x', verb == 'X':
+		var dst [32]byte
+
+		if verb == 'X' {
+			EncodeHexUpper(&dst, (*[16]byte)(&u))
+		} else {
+			EncodeHexLower(&dst, (*[16]byte)(&u))
+		}
+
+		s = string(dst[:])
+	default:
+		fmt.Fprintf(f, "%%!%c(uuid.UUID=%s)", verb, u.String())
+		return
+	}
+
+	if verb != 'q' {
+		verb = 's'
+	}
+
+	fmt.Fprintf(f, fmt.FormatString(f, verb), s)
+}
+
+// Braced is the registry and COM form of a GUID, {6ba7b810-9dad-11d1-80b4-00c04fd430c8},
+// in the OutputCase set by Configure. FromString reads it back
+func (u UUID) Braced() string {
+	return "{" + u.String() + "}"
+}
+
+// Hex is the 32 hex digits without dashes, as MSSQL and Active Directory exports often
+// write them, in the OutputCase set by Configure. FromString reads it back
+func (u UUID) Hex() string {
+
+	var dst [32]byte
+
+	if currentConfig().OutputCase == UpperCase {
+		EncodeHexUpper(&dst, (*[16]byte)(&u))
+	} else {
+		EncodeHexLower(&dst, (*[16]byte)(&u))
+	}
+
+	return string(dst[:])
+}
+
+// trimBraces strips the braces of the Microsoft form
+func trimBraces(s string) string {
+
+	if len(s) > 1 && s[0] == '{' && s[len(s)-1] == '}' {
+		return s[1 : len(s)-1]
+	}
+
+	return s
+}
@@ -0,0 +1,71 @@
+package uuid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestBracedHex(t *testing.T) {
+
+	if s := NamespaceDNS.Braced(); s != "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}" {
+		t.Error("Braced should be: {6ba7b810-9dad-11d1-80b4-00c04fd430c8} got:", s)
+	}
+
+	if s := NamespaceDNS.Hex(); s != "6ba7b8109dad11d180b400c04fd430c8" {
+		t.Error("Hex should be: 6ba7b8109dad11d180b400c04fd430c8 got:", s)
+	}
+
+	tests := []string{
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}",
+		"6ba7b8109dad11d180b400c04fd430c8",
+		"6BA7B8109DAD11D180B400C04FD430C8",
+		NamespaceDNS.Braced(),
+		NamespaceDNS.Hex(),
+	}
+
+	for _, test := range tests {
+		if uuid, err := FromString(test); err != nil || uuid != NamespaceDNS {
+			t.Error("FromString should accept", test, err)
+		}
+	}
+
+	for _, test := range []string{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8", "{}", "{{6ba7b810-9dad-11d1-80b4-00c04fd430c8}}"} {
+		if _, err := FromString(test); err == nil {
+			t.Error("FromString accepted", test)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+
+	tests := map[string]string{
+		"%s":    "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"%v":    "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"%+v":   "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"%q":    `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`,
+		"%x":    "6ba7b8109dad11d180b400c04fd430c8",
+		"%X":    "6BA7B8109DAD11D180B400C04FD430C8",
+		"%#v":   `uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")`,
+		"%40s":  "    6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"%-34x": "6ba7b8109dad11d180b400c04fd430c8  ",
+		"%d":    "%!d(uuid.UUID=6ba7b810-9dad-11d1-80b4-00c04fd430c8)",
+	}
+
+	for format, want := range tests {
+		if s := fmt.Sprintf(format, NamespaceDNS); s != want {
+			t.Error(format, "should be:", want, "got:", s)
+		}
+	}
+
+	// the value receiver also covers pointers and the Stringer of fmt.Println
+	if s := fmt.Sprint(&NamespaceDNS, NamespaceURL); s != "6ba7b810-9dad-11d1-80b4-00c04fd430c8 6ba7b811-9dad-11d1-80b4-00c04fd430c8" {
+		t.Error("Sprint should print the strings, got:", s)
+	}
+
+	var s fmt.Stringer = NamespaceDNS
+
+	if s.String() != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Error("UUID should be a fmt.Stringer")
+	}
+}
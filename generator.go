@@ -0,0 +1,297 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"sync"
+)
+
+var (
+	// ErrReadOnly is returned when a read only Generator is asked to create a UUID
+	ErrReadOnly = errors.New("generator is read only")
+
+	// ErrInconsistent is returned by a strict Generator when version or variant bits are wrong
+	ErrInconsistent = errors.New("UUID version or variant bits are inconsistent")
+)
+
+// Generator holds the state shared by time based UUIDs (hardware address and clock sequence)
+// The package level NewV1, NewV2 and NewV4 use a default Generator; create your own when
+// you need different options
+type Generator struct {
+	mu           sync.Mutex // prevents races on clockSeq
+	addr         [6]byte    // hardware address used for v1 and v2
+	nodeSource   NodeSource // where addr came from, 0 until it is set
+	clockSeq     uint16     // used for v1 and v2
+	clockSeqBase uint16     // first clock sequence of the range assigned by EnableHostLock
+	clockSeqSpan uint16     // 0 means the whole clock sequence is in use
+	readOnly     bool
+	strict       bool
+	rand         io.Reader // nil uses the package source
+	clock        Clock     // nil uses the package clock, see WithClock
+	lastTime     uint64    // last v1 or v6 timestamp, see stale
+	lastClock    uint64    // clock reading lastTime was made from
+	subTick      bool      // see WithSubTickSequence
+	quota        *quota    // see WithQuota
+
+	store     StateStore // see WithStateStore
+	savedTime uint64     // timestamp last written to store
+	savedSeq  uint16     // clock sequence last written to store
+
+	rotation []NodeSlot // see WithNodeRotation
+	turn     int        // next slot of rotation
+
+	lastV7   int64         // unix milliseconds of the last v7
+	counter7 uint16        // 12 bit counter in rand_a of the last v7
+	seqFile  *SequenceFile // see WithSequenceFile
+
+	leases *LeaseCoordinator // see WithEpochLeases
+	lease  EpochLease        // current epoch, the zero value has run out
+}
+
+// Option configures a Generator, see NewGenerator
+type Option func(*Generator)
+
+// WithReadOnly makes every New call on the Generator return ErrReadOnly.
+// It is meant for replays and backtests where minting an ID by accident would corrupt results;
+// parsing and validation with FromString and FromBytes keep working
+func WithReadOnly() Option {
+	return func(g *Generator) {
+		g.readOnly = true
+	}
+}
+
+// WithStrictOutput makes the Generator check the version and variant bits of every UUID
+// before returning it, and return ErrInconsistent instead of a corrupted UUID
+func WithStrictOutput() Option {
+	return func(g *Generator) {
+		g.strict = true
+	}
+}
+
+// WithRandSource makes the Generator read its random bits from r instead of the package source
+// (see SetRandSource). r is only read while the Generator holds its lock
+func WithRandSource(r io.Reader) Option {
+	return func(g *Generator) {
+		g.rand = r
+	}
+}
+
+// NewGenerator creates a Generator with a random clock sequence and, unless an option sets one,
+// the node ID found by the ladder described at NodeSource when it is first needed
+func NewGenerator(opts ...Option) *Generator {
+
+	g := &Generator{}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	g.clockSeq = g.randomClockSeq()
+
+	for i := range g.rotation {
+		s := &g.rotation[i]
+		s.clockSeq = g.randomClockSeq()
+
+		if s.ClockSeqSpan > 0 {
+			s.clockSeq = s.ClockSeqBase + s.clockSeq%s.ClockSeqSpan
+		}
+	}
+
+	g.loadState()
+
+	return g
+}
+
+// NewV1 See https://tools.ietf.org/html/rfc4122#section-4.2.1
+func (g *Generator) NewV1() (UUID, error) {
+	return g.newTime(&uuidTime{now: g.now}, 1, insertTimestamp, false)
+}
+
+// NewV6 See https://www.rfc-editor.org/rfc/rfc9562#section-5.6
+// It is v1 with the timestamp fields reordered most significant first, so v6 UUIDs sort by time.
+// It shares the clock sequence and node with NewV1
+func (g *Generator) NewV6() (UUID, error) {
+	return g.newTime(&uuidTime{now: g.now}, 6, insertTimestampV6, false)
+}
+
+// NewV4 See https://tools.ietf.org/html/rfc4122#section-4.4
+// With crypto/rand as the source it takes no lock; the bits come from pooled 4KB reads
+func (g *Generator) NewV4() (UUID, error) {
+
+	var uuid UUID
+
+	if err := g.allow(1); err != nil {
+		return uuid, err
+	}
+
+	read := pooledRandom
+
+	// other sources are only read under the lock, and may be deterministic
+	if g.rand != nil || currentConfig().Rand != nil {
+		g.mu.Lock()
+		defer g.mu.Unlock()
+
+		read = g.randomBytes
+	}
+
+	timeSource := &uuidRand{read: read}
+	ts, _ := timeSource.timestamp() // random bits never fail
+	insertTimestamp(uuid[:], ts)
+	uuid.version(4)
+
+	// From Doc: Set all the other bits to randomly (or pseudo-randomly) chosen values
+	read(uuid[8:])
+	uuid.variant(rfc4122) // must set after the random bits
+
+	return g.output(uuid)
+}
+
+// newTime builds v1, v2 and v6 which only differ by their timestamp and its layout.
+// once is set when timeSource is only for this call, see NewV1WithTime
+func (g *Generator) newTime(timeSource timestamp, v byte, insert func([]byte, uint64), once bool) (UUID, error) {
+
+	var uuid UUID
+
+	if err := g.allow(1); err != nil {
+		return uuid, err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ts, err := timeSource.timestamp()
+
+	if err != nil {
+		return uuid, err
+	}
+
+	addr, seq, last, lastClock := g.node(), &g.clockSeq, &g.lastTime, &g.lastClock
+	base, span := g.clockSeqBase, g.clockSeqSpan
+
+	if len(g.rotation) > 0 {
+		s := &g.rotation[g.turn]
+		g.turn = (g.turn + 1) % len(g.rotation)
+		addr, seq, last, lastClock, base, span = s.Node, &s.clockSeq, &s.lastTime, &s.lastClock, s.ClockSeqBase, s.ClockSeqSpan
+	}
+
+	switch {
+	case v == 2:
+		// the low 32 bits are the ID, so only the clock sequence can tell v2 UUIDs apart,
+		// and only its top 6 bits as clock_seq_low is the domain
+		if *seq, err = nextDCESeq(*seq, base, span); err != nil {
+			return uuid, err
+		}
+	case once:
+		// the time is not the clock's, so it must not move lastTime; a new clock sequence
+		// keeps it apart from UUIDs made from the clock at that time
+		*seq = nextClockSeq(*seq, base, span)
+	default:
+		if g.subTick {
+			ts -= ts % subTicks
+		}
+
+		ts = stale(ts, seq, last, lastClock, base, span)
+	}
+
+	insert(uuid[:], ts)
+	uuid.version(v)
+
+	binary.BigEndian.PutUint16(uuid[8:], *seq)
+	uuid.variant(rfc4122) // must set after setting clockSeq
+
+	copy(uuid[10:], addr[:])
+
+	if v != 2 {
+		if err = g.saveState(); err != nil {
+			return UUID{}, err
+		}
+	}
+
+	return g.output(uuid)
+}
+
+// output is the last step of every constructor, and the only one that takes from the quota
+func (g *Generator) output(uuid UUID) (UUID, error) {
+
+	if g.strict && !consistent(uuid) {
+		return UUID{}, ErrInconsistent
+	}
+
+	if err := g.spend(1); err != nil {
+		return UUID{}, err
+	}
+
+	return uuid, nil
+}
+
+// outputBatch is output for a batch: every UUID is checked before the batch is taken from the
+// quota, and on failure the batch is cleared, as output returns Nil
+func (g *Generator) outputBatch(uuids []UUID) error {
+
+	for _, uuid := range uuids {
+		if g.strict && !consistent(uuid) {
+			clear(uuids)
+			return ErrInconsistent
+		}
+	}
+
+	if err := g.spend(len(uuids)); err != nil {
+		clear(uuids)
+		return err
+	}
+
+	return nil
+}
+
+// stale applies https://tools.ietf.org/html/rfc4122#section-4.2.1 to the clock reading ts.
+// The clock sequence is only changed when the clock went backwards; a second UUID in the same
+// 100ns tick (or a faster burst) borrows the next tick instead, so time based UUIDs keep increasing
+func stale(ts uint64, seq *uint16, last, lastClock *uint64, base, span uint16) uint64 {
+
+	clock := ts
+
+	switch {
+	case clock < *lastClock:
+		*seq = nextClockSeq(*seq, base, span)
+	case ts <= *last:
+		ts = *last + 1
+	}
+
+	*last, *lastClock = ts, clock
+
+	return ts
+}
+
+// nextClockSeq advances the clock sequence cs, wrapping inside the range starting at base
+// (see EnableHostLock and NodeSlot). A span of 0 is the whole clock sequence
+func nextClockSeq(cs, base, span uint16) uint16 {
+	if span == 0 {
+		return cs + 1
+	}
+
+	return base + (cs-base+1)%span
+}
+
+// consistent reports whether the version is one this package creates (1 to 8) and the variant is RFC4122
+func consistent(u UUID) bool {
+	return knownVersion(u) && u[8]&0xC0 == 0x80
+}
+
+// randomBytes fills b from the Generator's source
+func (g *Generator) randomBytes(b []byte) {
+
+	if g.rand == nil {
+		randomBytes(b)
+		return
+	}
+
+	readRandom(g.rand, b)
+}
+
+// randomClockSeq See https://tools.ietf.org/html/rfc4122#section-4.1.5
+func (g *Generator) randomClockSeq() uint16 {
+	var b [2]byte
+	g.randomBytes(b[:])
+	return binary.BigEndian.Uint16(b[:])
+}
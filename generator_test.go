@@ -0,0 +1,131 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGeneratorReadOnly(t *testing.T) {
+
+	g := NewGenerator(WithReadOnly())
+
+	if _, err := g.NewV1(); err != ErrReadOnly {
+		t.Error("read only Generator created a v1", err)
+	}
+
+	if _, err := g.NewV2(DomainPerson, 0); err != ErrReadOnly {
+		t.Error("read only Generator created a v2", err)
+	}
+
+	if _, err := g.NewV4(); err != ErrReadOnly {
+		t.Error("read only Generator created a v4", err)
+	}
+
+	// parsing is still allowed
+	if _, err := FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGenerator(t *testing.T) {
+
+	g := NewGenerator()
+
+	uuid, err := g.NewV1()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !uuidRegex.MatchString(uuid.String()) {
+		t.Error("Generator v1 does not pass regex test", uuid.String())
+	}
+
+	if uuid, err = g.NewV4(); err != nil || !uuidRegex.MatchString(uuid.String()) {
+		t.Error("Generator v4 does not pass regex test", uuid.String(), err)
+	}
+}
+
+func TestConsistent(t *testing.T) {
+
+	g := NewGenerator(WithStrictOutput())
+
+	uuid, err := g.NewV1()
+
+	if err != nil || !consistent(uuid) {
+		t.Fatal("strict Generator rejected its own v1", uuid.String(), err)
+	}
+
+	uuid.version(8)
+
+	if !consistent(uuid) {
+		t.Error("consistent rejected version 8")
+	}
+
+	uuid.version(9)
+
+	if consistent(uuid) {
+		t.Error("consistent accepted version 9")
+	}
+
+	uuid.version(1)
+	uuid[8] &= 0x3F // NCS variant
+
+	if consistent(uuid) {
+		t.Error("consistent accepted NCS variant")
+	}
+
+	if _, err = g.output(uuid); err != ErrInconsistent {
+		t.Error("strict Generator did not catch bad variant", err)
+	}
+}
+
+type clockFunc func() time.Time
+
+func (f clockFunc) Now() time.Time {
+	return f()
+}
+
+func TestStale(t *testing.T) {
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	g := NewGenerator(WithClock(clockFunc(func() time.Time { return now })))
+
+	last, _ := g.NewV1()
+
+	// the same tick borrows the next one and keeps the clock sequence
+	for i := 0; i < testSize; i++ {
+		uuid, err := g.NewV1()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if v1Timestamp(uuid) != v1Timestamp(last)+1 {
+			t.Fatal("v1 in the same tick should be:", v1Timestamp(last)+1, "got:", v1Timestamp(uuid))
+		}
+
+		if uuid[8] != last[8] || uuid[9] != last[9] {
+			t.Fatal("clock sequence changed without the clock going backwards")
+		}
+
+		last = uuid
+	}
+
+	// the clock moving forward past the borrowed ticks is used as is
+	now = now.Add(time.Second)
+	uuid, _ := g.NewV1()
+
+	if v1Timestamp(uuid) <= v1Timestamp(last) || uuid[9] != last[9] {
+		t.Error("v1 after the clock moved on should keep the clock sequence", last.String(), uuid.String())
+	}
+
+	// going backwards changes the clock sequence
+	last = uuid
+	now = now.Add(-time.Minute)
+	uuid, _ = g.NewV1()
+
+	if uuid[8] == last[8] && uuid[9] == last[9] {
+		t.Error("clock sequence should change when the clock goes backwards", last.String(), uuid.String())
+	}
+}
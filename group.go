@@ -0,0 +1,92 @@
+package uuid
+
+import (
+	"context"
+	"sync"
+)
+
+// groupBuffer is how many UUIDs each worker of a GeneratorGroup may have waiting in the channel
+const groupBuffer = 256
+
+// GeneratorGroup runs worker goroutines, each with its own Generator so they never share a lock,
+// all feeding one channel. Workers blocked on a full channel are served in the order they blocked,
+// so a slow consumer gets UUIDs interleaved fairly across workers.
+// Time based versions get a random clock sequence per worker; give workers their own node IDs
+// (e.g. WithNodeRotation slots) when v1 or v6 UUIDs must never collide
+type GeneratorGroup struct {
+	out    chan UUID
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	once sync.Once
+	err  error
+}
+
+// NewGeneratorGroup starts n workers (at least 1) minting UUIDs with gen, such as (*Generator).NewV4.
+// Each worker's Generator is made with opts, so the workers share what the options hold: one
+// WithQuota caps the whole group. WithSequenceFile and WithStateStore must not be used with more
+// than one worker, as a SequenceFile or StateStore belongs to a single Generator.
+// The workers stop when ctx is done, Stop is called or gen returns an error; the channel is
+// closed once they have all stopped
+func NewGeneratorGroup(ctx context.Context, n int, gen func(*Generator) (UUID, error), opts ...Option) *GeneratorGroup {
+
+	if n < 1 {
+		n = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	gg := &GeneratorGroup{out: make(chan UUID, n*groupBuffer), cancel: cancel}
+
+	gg.wg.Add(n)
+
+	for i := 0; i < n; i++ {
+		go gg.work(ctx, NewGenerator(opts...), gen)
+	}
+
+	go func() {
+		gg.wg.Wait()
+		cancel()
+		close(gg.out)
+	}()
+
+	return gg
+}
+
+func (gg *GeneratorGroup) work(ctx context.Context, g *Generator, gen func(*Generator) (UUID, error)) {
+
+	defer gg.wg.Done()
+
+	for {
+		uuid, err := gen(g)
+
+		if err != nil {
+			gg.once.Do(func() { gg.err = err })
+			gg.cancel()
+			return
+		}
+
+		select {
+		case gg.out <- uuid:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// C is the channel the workers feed. It is closed once every worker has stopped
+func (gg *GeneratorGroup) C() <-chan UUID {
+	return gg.out
+}
+
+// Stop shuts the workers down and waits for them, returning the first error a worker hit
+func (gg *GeneratorGroup) Stop() error {
+	gg.cancel()
+	return gg.Wait()
+}
+
+// Wait blocks until every worker has stopped and returns the first error a worker hit.
+// A done context is not an error
+func (gg *GeneratorGroup) Wait() error {
+	gg.wg.Wait()
+	return gg.err
+}
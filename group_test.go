@@ -0,0 +1,75 @@
+package uuid
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestGeneratorGroup(t *testing.T) {
+
+	gg := NewGeneratorGroup(context.Background(), 4, (*Generator).NewV4)
+	seen := make(map[UUID]bool, testSize)
+
+	for i := 0; i < testSize; i++ {
+		uuid := <-gg.C()
+
+		if seen[uuid] || !uuidRegex.MatchString(uuid.String()) {
+			t.Fatal("GeneratorGroup gave a duplicate or bad UUID", uuid.String())
+		}
+
+		seen[uuid] = true
+	}
+
+	if err := gg.Stop(); err != nil {
+		t.Error(err)
+	}
+
+	for range gg.C() {
+		// drain what was buffered, the channel must be closed
+	}
+}
+
+func TestGeneratorGroupError(t *testing.T) {
+
+	gg := NewGeneratorGroup(context.Background(), 2, (*Generator).NewV4, WithReadOnly())
+
+	for range gg.C() {
+		t.Fatal("read only workers should not send")
+	}
+
+	if err := gg.Wait(); err != ErrReadOnly {
+		t.Error("Wait should be:", ErrReadOnly, "got:", err)
+	}
+}
+
+func TestGeneratorGroupContext(t *testing.T) {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	gg := NewGeneratorGroup(ctx, 2, (*Generator).NewV7)
+
+	<-gg.C()
+	cancel()
+
+	if err := gg.Wait(); err != nil {
+		t.Error("a done context should not be an error, got:", err)
+	}
+}
+
+func TestGeneratorGroupQuota(t *testing.T) {
+
+	gg := NewGeneratorGroup(context.Background(), 4, (*Generator).NewV4, WithQuota(10, time.Hour))
+	n := 0
+
+	for range gg.C() {
+		n++
+	}
+
+	if err := gg.Wait(); err != ErrQuotaExceeded {
+		t.Error("Wait should be:", ErrQuotaExceeded, "got:", err)
+	}
+
+	if n != 10 {
+		t.Error("the group should share one quota of 10, got:", n)
+	}
+}
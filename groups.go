@@ -0,0 +1,54 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+// FormatGroups renders the 32 hex digits split into groups of the given sizes joined by sep,
+// e.g. FormatGroups(" ", []int{8, 8, 8, 8}) for printed documents.
+// Digits left over after the last group form one more group
+func (u UUID) FormatGroups(sep string, groups []int) string {
+
+	h := hex.EncodeToString(u[:])
+	parts := make([]string, 0, len(groups)+1)
+
+	for _, n := range groups {
+		if n <= 0 || len(h) == 0 {
+			continue
+		}
+
+		if n > len(h) {
+			n = len(h)
+		}
+
+		parts = append(parts, h[:n])
+		h = h[n:]
+	}
+
+	if len(h) > 0 {
+		parts = append(parts, h)
+	}
+
+	return strings.Join(parts, sep)
+}
+
+// ParseGroups is the lenient parser for FormatGroups: every character that is not a hex digit
+// is dropped and the remaining 32 digits are decoded in either case
+func ParseGroups(s string) (UUID, error) {
+
+	digits := make([]byte, 0, hex.EncodedLen(uuidSize))
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F') {
+			digits = append(digits, c)
+		}
+	}
+
+	if len(digits) != hex.EncodedLen(uuidSize) {
+		return UUID{}, ErrUUIDFormat
+	}
+
+	return parse(string(digits))
+}
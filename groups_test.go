@@ -0,0 +1,39 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestFormatGroups(t *testing.T) {
+
+	uuid, _ := FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	tests := []struct {
+		sep    string
+		groups []int
+		want   string
+	}{
+		{"-", []int{8, 4, 4, 4, 12}, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{" ", []int{8, 8, 8, 8}, "6ba7b810 9dad11d1 80b400c0 4fd430c8"},
+		{":", []int{16}, "6ba7b8109dad11d1:80b400c04fd430c8"},
+		{"", nil, "6ba7b8109dad11d180b400c04fd430c8"},
+	}
+
+	for _, test := range tests {
+		s := uuid.FormatGroups(test.sep, test.groups)
+
+		if s != test.want {
+			t.Error("FormatGroups is", s, "should be:", test.want)
+		}
+
+		back, err := ParseGroups(s)
+
+		if err != nil || back != uuid {
+			t.Error("ParseGroups did not round trip", s, err)
+		}
+	}
+
+	if _, err := ParseGroups("6ba7b810 9dad11d1"); err != ErrUUIDFormat {
+		t.Error("ParseGroups did not detect missing digits")
+	}
+}
@@ -0,0 +1,25 @@
+package uuid
+
+const (
+	hexLower = "0123456789abcdef"
+	hexUpper = "0123456789ABCDEF"
+)
+
+// EncodeHexLower writes the 16 bytes of src as 32 lowercase hex digits into dst.
+// The fixed sizes let the compiler drop every bounds check; slices convert with
+// EncodeHexLower((*[32]byte)(dst), (*[16]byte)(src))
+func EncodeHexLower(dst *[32]byte, src *[16]byte) {
+	encodeHex(dst, src, hexLower)
+}
+
+// EncodeHexUpper is EncodeHexLower with uppercase digits
+func EncodeHexUpper(dst *[32]byte, src *[16]byte) {
+	encodeHex(dst, src, hexUpper)
+}
+
+func encodeHex(dst *[32]byte, src *[16]byte, table string) {
+	for i, b := range src {
+		dst[i*2] = table[b>>4]
+		dst[i*2+1] = table[b&0x0F]
+	}
+}
@@ -0,0 +1,38 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestEncodeHex(t *testing.T) {
+
+	var dst [32]byte
+
+	for i := 0; i < 100; i++ {
+		src := [16]byte(NewV4())
+		want := hex.EncodeToString(src[:])
+
+		EncodeHexLower(&dst, &src)
+
+		if string(dst[:]) != want {
+			t.Error("EncodeHexLower is", string(dst[:]), "should be:", want)
+		}
+
+		EncodeHexUpper(&dst, &src)
+
+		if string(dst[:]) != strings.ToUpper(want) {
+			t.Error("EncodeHexUpper is", string(dst[:]))
+		}
+	}
+}
+
+func BenchmarkEncodeHexLower(b *testing.B) {
+	var dst [32]byte
+	src := [16]byte(DNSNamespace)
+
+	for n := 0; n < b.N; n++ {
+		EncodeHexLower(&dst, &src)
+	}
+}
@@ -0,0 +1,65 @@
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+const (
+	// hostSlots is the number of disjoint clock sequence ranges EnableHostLock hands out.
+	// The clock sequence is 14 bits (the top 2 belong to the variant) so each range is 256 wide
+	hostSlots     = 64
+	hostSlotRange = (1 << 14) / hostSlots
+)
+
+var (
+	hostLock *os.File // lock file held for the life of the process
+
+	// ErrHostLockUnsupported is returned by EnableHostLock on platforms without file locks
+	ErrHostLockUnsupported = errors.New("host lock is not supported on this platform")
+
+	// ErrHostLockExhausted is returned when every clock sequence range is held by another process
+	ErrHostLockExhausted = errors.New("all host lock slots are in use")
+
+	errSlotBusy = errors.New("host lock slot is busy")
+)
+
+// EnableHostLock coordinates v1 and v6 generation between processes that share a hardware address.
+// It locks the first free file of path.0 through path.63 and confines this process's clock sequence
+// to the range belonging to that slot. The lock is held until the process exits, so a crashed
+// process frees its range automatically. Calling it again once a slot is held is a no-op.
+// Only the default Generator used by the package level constructors is confined to the range.
+// A range leaves v2 a single clock sequence, so afterwards its NewV2 returns ErrDCESeqRange
+func EnableHostLock(path string) error {
+
+	g := defaultGenerator
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if hostLock != nil {
+		return nil
+	}
+
+	for slot := 0; slot < hostSlots; slot++ {
+		f, err := lockSlot(fmt.Sprintf("%s.%d", path, slot))
+
+		if err == errSlotBusy {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		hostLock = f
+		g.clockSeqBase = uint16(slot * hostSlotRange)
+		g.clockSeqSpan = hostSlotRange
+		g.clockSeq = g.clockSeqBase + g.clockSeq%g.clockSeqSpan
+
+		return nil
+	}
+
+	return ErrHostLockExhausted
+}
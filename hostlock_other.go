@@ -0,0 +1,11 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+
+package uuid
+
+import (
+	"os"
+)
+
+func lockSlot(path string) (*os.File, error) {
+	return nil, ErrHostLockUnsupported
+}
@@ -0,0 +1,56 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestEnableHostLock(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "uuid.lock")
+
+	// hold slot 0 as if another process had it
+	other, err := lockSlot(path + ".0")
+
+	if err == ErrHostLockUnsupported {
+		t.Skip(err)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+
+	if err = EnableHostLock(path); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		g := defaultGenerator
+		g.mu.Lock()
+		hostLock.Close()
+		hostLock, g.clockSeqBase, g.clockSeqSpan = nil, 0, 0
+		g.mu.Unlock()
+		os.Remove(path + ".1")
+	}()
+
+	if defaultGenerator.clockSeqBase != hostSlotRange {
+		t.Error("EnableHostLock did not skip busy slot, base:", defaultGenerator.clockSeqBase)
+	}
+
+	for i := 0; i < hostSlotRange*2; i++ {
+		uuid := NewV1()
+		cs := binary.BigEndian.Uint16(uuid[8:]) & 0x3FFF
+
+		if cs < hostSlotRange || cs >= 2*hostSlotRange {
+			t.Fatal("Clock sequence outside of host lock range:", cs)
+		}
+	}
+
+	// a 256 wide range leaves v2 one value of its 6 bits
+	if _, err = defaultGenerator.NewV2(DomainPerson, 1000); err != ErrDCESeqRange {
+		t.Error("v2 with the host lock should be:", ErrDCESeqRange, "got:", err)
+	}
+}
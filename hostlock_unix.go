@@ -0,0 +1,31 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd
+
+package uuid
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockSlot takes a non-blocking exclusive flock on path
+// errSlotBusy is returned if another process holds it
+func lockSlot(path string) (*os.File, error) {
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err = syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		f.Close()
+
+		if err == syscall.EWOULDBLOCK {
+			return nil, errSlotBusy
+		}
+
+		return nil, err
+	}
+
+	return f, nil
+}
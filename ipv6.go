@@ -0,0 +1,50 @@
+package uuid
+
+import (
+	"errors"
+	"net/netip"
+)
+
+var (
+	// ErrIPv6Pair is returned when the addresses given to FromIPv6Pair are not IPv6
+	// or do not share a /64 prefix
+	ErrIPv6Pair = errors.New("addresses are not IPv6 in the same /64")
+)
+
+// IPv6Pair splits the UUID across two addresses of the /64 prefix: hi carries bytes 0-7 and lo bytes 8-15
+// as the interface identifier. Time ordered UUIDs keep their timestamp in the first bytes,
+// so the hi addresses sort by time. Only the first 8 bytes of prefix are used
+func (u UUID) IPv6Pair(prefix netip.Addr) (hi, lo netip.Addr) {
+
+	p := prefix.As16()
+
+	var a, b [16]byte
+
+	copy(a[:8], p[:8])
+	copy(b[:8], p[:8])
+	copy(a[8:], u[:8])
+	copy(b[8:], u[8:])
+
+	return netip.AddrFrom16(a), netip.AddrFrom16(b)
+}
+
+// FromIPv6Pair reassembles a UUID split by IPv6Pair
+func FromIPv6Pair(hi, lo netip.Addr) (UUID, error) {
+
+	if !hi.Is6() || !lo.Is6() || hi.Is4In6() || lo.Is4In6() {
+		return UUID{}, ErrIPv6Pair
+	}
+
+	a, b := hi.As16(), lo.As16()
+
+	if [8]byte(a[:8]) != [8]byte(b[:8]) {
+		return UUID{}, ErrIPv6Pair
+	}
+
+	var uuid UUID
+
+	copy(uuid[:8], a[8:])
+	copy(uuid[8:], b[8:])
+
+	return FromBytes(uuid[:])
+}
@@ -0,0 +1,33 @@
+package uuid
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIPv6Pair(t *testing.T) {
+
+	prefix := netip.MustParseAddr("fd00:1234:5678:9abc::")
+
+	hi, lo := DNSNamespace.IPv6Pair(prefix)
+
+	if hi.String() != "fd00:1234:5678:9abc:6ba7:b810:9dad:11d1" || lo.String() != "fd00:1234:5678:9abc:80b4:c0:4fd4:30c8" {
+		t.Error("IPv6Pair is", hi, lo)
+	}
+
+	back, err := FromIPv6Pair(hi, lo)
+
+	if err != nil || back != DNSNamespace {
+		t.Error("FromIPv6Pair did not round trip", err)
+	}
+
+	other, _ := NewV4().IPv6Pair(netip.MustParseAddr("fd00::"))
+
+	if _, err = FromIPv6Pair(other, lo); err != ErrIPv6Pair {
+		t.Error("FromIPv6Pair accepted different prefixes", err)
+	}
+
+	if _, err = FromIPv6Pair(netip.MustParseAddr("10.0.0.1"), lo); err != ErrIPv6Pair {
+		t.Error("FromIPv6Pair accepted IPv4", err)
+	}
+}
@@ -0,0 +1,89 @@
+package uuid
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// InventoryRecord is one line of a JSON Lines ID inventory: {"id":"<canonical uuid>","meta":{...}}
+// Meta is left as raw JSON so importers can decode it into whatever type the exporter used
+type InventoryRecord struct {
+	ID   UUID            `json:"id"`
+	Meta json.RawMessage `json:"meta,omitempty"`
+}
+
+type inventoryLine struct {
+	ID   string      `json:"id"`
+	Meta interface{} `json:"meta,omitempty"`
+}
+
+// ExportJSONL writes one InventoryRecord per line for data governance tooling that inventories
+// the identifiers a service has issued. IDs are always in lowercase canonical form, whatever
+// Configure says, so any JSON Lines reader can join them. meta may be nil, or return nil for no meta
+func ExportJSONL(w io.Writer, ids []UUID, meta func(UUID) any) error {
+
+	enc := json.NewEncoder(w) // Encode ends every value with a newline
+
+	for _, id := range ids {
+		line := inventoryLine{ID: id.canonical()}
+
+		if meta != nil {
+			line.Meta = meta(id)
+		}
+
+		if err := enc.Encode(line); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// JSONLImporter streams InventoryRecords written by ExportJSONL (or any tool writing the same shape)
+// without holding the inventory in memory
+type JSONLImporter struct {
+	dec *json.Decoder
+	i   int
+	cur InventoryRecord
+	err error
+}
+
+// NewJSONLImporter reads records from r, see Next
+func NewJSONLImporter(r io.Reader) *JSONLImporter {
+	return &JSONLImporter{dec: json.NewDecoder(r)}
+}
+
+// Next reads the next record, false at the end of the input or on the first bad record
+func (im *JSONLImporter) Next() bool {
+
+	if im.err != nil {
+		return false
+	}
+
+	var rec InventoryRecord
+	err := im.dec.Decode(&rec)
+
+	if err == io.EOF {
+		return false
+	}
+
+	if err != nil {
+		im.err = &ParseError{Index: im.i, Err: err}
+		return false
+	}
+
+	im.cur = rec
+	im.i++
+
+	return true
+}
+
+// Record returns the record read by Next
+func (im *JSONLImporter) Record() InventoryRecord {
+	return im.cur
+}
+
+// Err returns the error that stopped the import, a *ParseError with the record index, if any
+func (im *JSONLImporter) Err() error {
+	return im.err
+}
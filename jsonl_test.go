@@ -0,0 +1,69 @@
+package uuid
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestExportJSONL(t *testing.T) {
+
+	ids := []UUID{NamespaceDNS, NamespaceURL}
+	var buf bytes.Buffer
+
+	err := ExportJSONL(&buf, ids, func(u UUID) any {
+		if u == NamespaceURL {
+			return nil
+		}
+
+		return map[string]string{"owner": "dns"}
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8","meta":{"owner":"dns"}}
+{"id":"6ba7b811-9dad-11d1-80b4-00c04fd430c8"}
+`
+
+	if buf.String() != want {
+		t.Error("ExportJSONL should be:", want, "got:", buf.String())
+	}
+
+	im := NewJSONLImporter(&buf)
+	var got []InventoryRecord
+
+	for im.Next() {
+		got = append(got, im.Record())
+	}
+
+	if err := im.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 2 || got[0].ID != NamespaceDNS || string(got[0].Meta) != `{"owner":"dns"}` || got[1].ID != NamespaceURL || got[1].Meta != nil {
+		t.Error("import does not match export:", got)
+	}
+}
+
+func TestJSONLImporterError(t *testing.T) {
+
+	in := `{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8"}
+{"id":"not a uuid"}
+{"id":"6ba7b811-9dad-11d1-80b4-00c04fd430c8"}
+`
+	im := NewJSONLImporter(strings.NewReader(in))
+	n := 0
+
+	for im.Next() {
+		n++
+	}
+
+	var perr *ParseError
+
+	if n != 1 || !errors.As(im.Err(), &perr) || perr.Index != 1 {
+		t.Error("import should stop at record 1, got:", n, im.Err())
+	}
+}
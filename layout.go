@@ -0,0 +1,113 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"slices"
+)
+
+// FieldSpec describes one field of a UUID layout. Offsets and widths are in bits, bit 0 being the
+// most significant bit of byte 0, as the field can be narrower than a byte (version, variant)
+type FieldSpec struct {
+	Name    string // the RFC 9562 name, e.g. time_low
+	Offset  int    // first bit
+	Bits    int    // width, at most 62
+	Meaning string
+}
+
+// Value reads the field from u
+func (f FieldSpec) Value(u UUID) uint64 {
+
+	hi, lo := binary.BigEndian.Uint64(u[0:]), binary.BigEndian.Uint64(u[8:])
+	shift := uuidSize*8 - f.Offset - f.Bits // of the field's last bit from the end
+
+	var v uint64
+
+	switch {
+	case shift >= 64:
+		v = hi >> (shift - 64)
+	case shift == 0:
+		v = lo
+	default:
+		v = lo>>shift | hi<<(64-shift)
+	}
+
+	return v & (1<<f.Bits - 1)
+}
+
+var (
+	versionField = FieldSpec{"ver", 48, 4, "version"}
+	variantField = FieldSpec{"var", 64, 2, "variant, 0b10 for RFC 9562"}
+
+	layouts = map[Version][]FieldSpec{
+		1: {
+			{"time_low", 0, 32, "low 32 bits of the 60 bit count of 100ns since 1582-10-15"},
+			{"time_mid", 32, 16, "middle 16 bits of the timestamp"},
+			versionField,
+			{"time_high", 52, 12, "high 12 bits of the timestamp"},
+			variantField,
+			{"clock_seq", 66, 14, "clock sequence, changed when the clock goes back or the node changes"},
+			{"node", 80, 48, "node ID, a MAC address or 48 random bits with the multicast bit set"},
+		},
+		2: {
+			{"local_id", 0, 32, "local ID of Domain: UID, GID or organization, in place of time_low"},
+			{"time_mid", 32, 16, "middle 16 bits of the timestamp"},
+			versionField,
+			{"time_high", 52, 12, "high 12 bits of the timestamp"},
+			variantField,
+			{"clock_seq", 66, 6, "high 6 bits of the clock sequence"},
+			{"domain", 72, 8, "DCE Security domain of local_id, in place of clock_seq_low"},
+			{"node", 80, 48, "node ID, a MAC address or 48 random bits with the multicast bit set"},
+		},
+		3: {
+			{"md5_high", 0, 48, "first 48 bits of the MD5 of namespace and name"},
+			versionField,
+			{"md5_mid", 52, 12, "next 12 bits of the hash"},
+			variantField,
+			{"md5_low", 66, 62, "last 62 bits of the hash used"},
+		},
+		4: {
+			{"random_a", 0, 48, "random"},
+			versionField,
+			{"random_b", 52, 12, "random"},
+			variantField,
+			{"random_c", 66, 62, "random"},
+		},
+		5: {
+			{"sha1_high", 0, 48, "first 48 bits of the SHA-1 of namespace and name"},
+			versionField,
+			{"sha1_mid", 52, 12, "next 12 bits of the hash"},
+			variantField,
+			{"sha1_low", 66, 62, "last 62 bits of the hash used"},
+		},
+		6: {
+			{"time_high", 0, 32, "high 32 bits of the 60 bit count of 100ns since 1582-10-15"},
+			{"time_mid", 32, 16, "middle 16 bits of the timestamp"},
+			versionField,
+			{"time_low", 52, 12, "low 12 bits of the timestamp"},
+			variantField,
+			{"clock_seq", 66, 14, "clock sequence, changed when the clock goes back or the node changes"},
+			{"node", 80, 48, "node ID, a MAC address or 48 random bits with the multicast bit set"},
+		},
+		7: {
+			{"unix_ts_ms", 0, 48, "milliseconds since 1970-01-01 UTC"},
+			versionField,
+			{"rand_a", 52, 12, "counter of UUIDs within the millisecond, from a random start"},
+			variantField,
+			{"rand_b", 66, 62, "random"},
+		},
+		8: {
+			{"custom_a", 0, 48, "defined by the application"},
+			versionField,
+			{"custom_b", 52, 12, "defined by the application"},
+			variantField,
+			{"custom_c", 66, 62, "defined by the application"},
+		},
+	}
+)
+
+// LayoutOf returns the fields of a version 1-8 UUID in order, covering all 128 bits, for inspection
+// tools that annotate a UUID without tables of their own (see FieldSpec.Value). Other versions,
+// Nil and Max included, have no layout and return nil. The slice is the caller's to modify
+func LayoutOf(v Version) []FieldSpec {
+	return slices.Clone(layouts[v])
+}
@@ -0,0 +1,73 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLayoutOf(t *testing.T) {
+
+	for v := Version(1); v <= 8; v++ {
+		fields := LayoutOf(v)
+		next := 0
+
+		for _, f := range fields {
+			if f.Offset != next || f.Bits < 1 || f.Bits > 62 {
+				t.Error("version", v, "field", f.Name, "should start at:", next, "got:", f.Offset, f.Bits)
+			}
+
+			next = f.Offset + f.Bits
+		}
+
+		if next != 128 {
+			t.Error("version", v, "layout should cover 128 bits, got:", next)
+		}
+	}
+
+	for _, v := range []Version{0, 9, 15} {
+		if fields := LayoutOf(v); fields != nil {
+			t.Error("version", v, "should have no layout, got:", fields)
+		}
+	}
+
+	LayoutOf(4)[0].Name = "changed"
+
+	if LayoutOf(4)[0].Name != "random_a" {
+		t.Error("LayoutOf should return a copy")
+	}
+}
+
+func TestFieldSpecValue(t *testing.T) {
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	uuid, _ := NewGenerator(WithClock(FixedClock(now))).NewV7()
+	values := make(map[string]uint64)
+
+	for _, f := range LayoutOf(7) {
+		values[f.Name] = f.Value(uuid)
+	}
+
+	if values["unix_ts_ms"] != uint64(now.UnixMilli()) || values["ver"] != 7 || values["var"] != 0b10 {
+		t.Error("v7 fields should be the time, 7 and 0b10, got:", values)
+	}
+
+	dce := NewV2(DomainGroup, 1000)
+	values = make(map[string]uint64)
+
+	for _, f := range LayoutOf(2) {
+		values[f.Name] = f.Value(dce)
+	}
+
+	if values["local_id"] != 1000 || values["domain"] != uint64(DomainGroup) || values["node"] != binaryNode(dce) {
+		t.Error("v2 fields should be the ID, domain and node, got:", values)
+	}
+
+	if values["clock_seq"] != uint64(dce.ClockSequence()>>8) {
+		t.Error("v2 clock_seq should be:", dce.ClockSequence()>>8, "got:", values["clock_seq"])
+	}
+}
+
+func binaryNode(u UUID) uint64 {
+	node := u.NodeID()
+	return uint64(node[0])<<40 | uint64(node[1])<<32 | uint64(node[2])<<24 | uint64(node[3])<<16 | uint64(node[4])<<8 | uint64(node[5])
+}
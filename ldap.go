@@ -0,0 +1,31 @@
+package uuid
+
+import (
+	"strings"
+)
+
+// EntryUUID formats the UUID for the LDAP entryUUID attribute
+// See https://tools.ietf.org/html/rfc4530#section-2.1 which uses the lowercase RFC4122 string form
+func (u UUID) EntryUUID() string {
+	return u.canonical()
+}
+
+// FromEntryUUID parses an entryUUID attribute value.
+// Some directory servers export uppercase or braced values, so both are accepted
+func FromEntryUUID(s string) (UUID, error) {
+
+	var uuid UUID
+
+	s = strings.TrimSpace(s)
+
+	if strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}") {
+		s = s[1 : len(s)-1]
+	}
+
+	// entryUUID always keeps the dashes
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return uuid, ErrUUIDFormat
+	}
+
+	return parse(s)
+}
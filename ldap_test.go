@@ -0,0 +1,34 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestFromEntryUUID(t *testing.T) {
+
+	want := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+	tests := []string{
+		want,
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		" {6BA7B810-9DAD-11D1-80B4-00C04FD430C8} ",
+	}
+
+	for _, test := range tests {
+		uuid, err := FromEntryUUID(test)
+
+		if err != nil {
+			t.Error("FromEntryUUID failed on", test, err)
+			continue
+		}
+
+		if uuid.EntryUUID() != want {
+			t.Error("FromEntryUUID is", uuid.EntryUUID(), "should be:", want)
+		}
+	}
+
+	if _, err := FromEntryUUID("6ba7b8109dad11d180b400c04fd430c8"); err != ErrUUIDFormat {
+		t.Error("FromEntryUUID should require dashes")
+	}
+}
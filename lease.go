@@ -0,0 +1,107 @@
+package uuid
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrLeaseContention is returned when Acquire loses the compare and swap too many times in a row
+var ErrLeaseContention = errors.New("could not acquire an epoch lease")
+
+// leaseRetries is how many times Acquire retries a lost compare and swap
+const leaseRetries = 16
+
+// LeaseStore is the shared key value store (etcd, Consul, a database row...) that holds the end
+// of the last epoch handed out, in Unix milliseconds. Load returns 0 before any epoch was handed out.
+// CompareAndSwap must only store new while the value is still old, atomically across all writers
+type LeaseStore interface {
+	Load(ctx context.Context) (int64, error)
+	CompareAndSwap(ctx context.Context, old, new int64) (bool, error)
+}
+
+// EpochLease is a range of Unix milliseconds, Start included and End not, that only one writer may use
+type EpochLease struct {
+	Start int64
+	End   int64
+}
+
+// LeaseCoordinator hands out non-overlapping, increasing epochs from a LeaseStore so several
+// writers can mint v7 UUIDs that never share a millisecond, see WithEpochLeases.
+// The store is only used when a writer's epoch runs out, not on every UUID
+type LeaseCoordinator struct {
+	store LeaseStore
+	span  int64
+}
+
+// NewLeaseCoordinator hands out epochs of span milliseconds (at least 1).
+// Longer spans mean fewer trips to the store, but a writer's UUIDs can run ahead of its clock by up to
+// the span of every writer that leased before it
+func NewLeaseCoordinator(store LeaseStore, span int64) *LeaseCoordinator {
+
+	if span < 1 {
+		span = 1
+	}
+
+	return &LeaseCoordinator{store: store, span: span}
+}
+
+// Acquire leases the next epoch, starting at now or at the end of the last epoch handed out,
+// whichever is later
+func (c *LeaseCoordinator) Acquire(ctx context.Context, now int64) (EpochLease, error) {
+
+	for i := 0; i < leaseRetries; i++ {
+		end, err := c.store.Load(ctx)
+
+		if err != nil {
+			return EpochLease{}, err
+		}
+
+		l := EpochLease{Start: now, End: now + c.span}
+
+		if end > now {
+			l = EpochLease{Start: end, End: end + c.span}
+		}
+
+		ok, err := c.store.CompareAndSwap(ctx, end, l.End)
+
+		if err != nil {
+			return EpochLease{}, err
+		}
+
+		if ok {
+			return l, nil
+		}
+	}
+
+	return EpochLease{}, ErrLeaseContention
+}
+
+// WithEpochLeases confines the Generator's v7 timestamps to epochs leased from c. A writer's UUIDs
+// then sort after those of every writer that leased before it, giving cluster wide ordered v7 UUIDs
+// without a central sequencer. A new epoch is leased, with the Generator's lock held, when the clock
+// or the counter moves past the current one; an error from the store is returned by NewV7
+func WithEpochLeases(c *LeaseCoordinator) Option {
+	return func(g *Generator) {
+		g.leases = c
+	}
+}
+
+// leaseMs returns the millisecond a v7 made at ms may use, leasing a new epoch if ms is past the current one
+func (g *Generator) leaseMs(ms int64) (int64, error) {
+
+	if ms >= g.lease.End {
+		l, err := g.leases.Acquire(context.Background(), ms)
+
+		if err != nil {
+			return 0, err
+		}
+
+		g.lease = l
+	}
+
+	if ms < g.lease.Start {
+		return g.lease.Start, nil
+	}
+
+	return ms, nil
+}
@@ -0,0 +1,76 @@
+package uuid
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// memoryLeases is a LeaseStore shared by writers in the same process
+type memoryLeases struct {
+	mu  sync.Mutex
+	end int64
+}
+
+func (m *memoryLeases) Load(context.Context) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.end, nil
+}
+
+func (m *memoryLeases) CompareAndSwap(_ context.Context, old, new int64) (bool, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.end != old {
+		return false, nil
+	}
+
+	m.end = new
+
+	return true, nil
+}
+
+func TestLeaseCoordinator(t *testing.T) {
+
+	c := NewLeaseCoordinator(&memoryLeases{}, 100)
+
+	a, _ := c.Acquire(context.Background(), 1000)
+	b, _ := c.Acquire(context.Background(), 1000) // the clock has not moved, b starts where a ends
+	d, _ := c.Acquire(context.Background(), 5000)
+
+	if a != (EpochLease{1000, 1100}) || b != (EpochLease{1100, 1200}) || d != (EpochLease{5000, 5100}) {
+		t.Error("leases should be: {1000 1100} {1100 1200} {5000 5100} got:", a, b, d)
+	}
+}
+
+func TestWithEpochLeases(t *testing.T) {
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := NewLeaseCoordinator(&memoryLeases{}, 10)
+
+	// two writers with the same clock never share a millisecond, and the second sorts after the first
+	a := NewGenerator(WithClock(FixedClock(now)), WithEpochLeases(c))
+	b := NewGenerator(WithClock(FixedClock(now)), WithEpochLeases(c))
+
+	var last UUID
+
+	for _, g := range []*Generator{a, b} {
+		for i := 0; i < testSize/10; i++ {
+			uuid, err := g.NewV7()
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if bytes.Compare(uuid[:], last[:]) <= 0 {
+				t.Fatal("leased v7 should sort after:", last.String(), "got:", uuid.String())
+			}
+
+			last = uuid
+		}
+	}
+}
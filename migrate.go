@@ -0,0 +1,101 @@
+package uuid
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// MigrateToBinary fills the binary column to of table from the text UUIDs in column from, batch
+// rows per transaction, for tables moving from CHAR(36) to BINARY(16) or BLOB. Only rows where to
+// is NULL are read, so it can be stopped and run again, and rows written meanwhile by a service
+// using PreferBinaryColumns can fill to themselves. It returns the number of rows migrated.
+// A value of from that does not parse stops the migration with a *ParseError, since it would
+// otherwise be read again forever. table and the column names are put in the SQL as they are
+func MigrateToBinary(ctx context.Context, db *sql.DB, d Dialect, table, from, to string, batch int) (int, error) {
+
+	if batch <= 0 {
+		batch = 1000
+	}
+
+	sel, upd := migrateQueries(d, table, from, to, batch)
+	n := 0
+
+	for {
+		done, err := migrateBatch(ctx, db, sel, upd, n)
+		n += done
+
+		if err != nil || done == 0 {
+			return n, err
+		}
+	}
+}
+
+// migrateBatch migrates the rows returned by sel in one transaction. n is only used for error indexes
+func migrateBatch(ctx context.Context, db *sql.DB, sel, upd string, n int) (int, error) {
+
+	tx, err := db.BeginTx(ctx, nil)
+
+	if err != nil {
+		return 0, err
+	}
+
+	defer tx.Rollback() // no-op after Commit
+
+	rows, err := tx.QueryContext(ctx, sel)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var texts []string
+
+	for rows.Next() {
+		var s string
+
+		if err = rows.Scan(&s); err != nil {
+			rows.Close()
+			return 0, err
+		}
+
+		texts = append(texts, s)
+	}
+
+	rows.Close()
+
+	if err = rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for i, s := range texts {
+		id, err := parse(s)
+
+		if err != nil {
+			return 0, &ParseError{Index: n + i, Input: s, Err: err}
+		}
+
+		if _, err = tx.ExecContext(ctx, upd, id[:], s); err != nil {
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return len(texts), nil
+}
+
+// migrateQueries builds the select of a batch and the update of one row for d
+func migrateQueries(d Dialect, table, from, to string, batch int) (string, string) {
+
+	sel := fmt.Sprintf("SELECT %s FROM %s WHERE %s IS NULL AND %s IS NOT NULL LIMIT %d", from, table, to, from, batch)
+
+	if d == SQLServer {
+		sel = fmt.Sprintf("SELECT TOP %d %s FROM %s WHERE %s IS NULL AND %s IS NOT NULL", batch, from, table, to, from)
+	}
+
+	upd := fmt.Sprintf("UPDATE %s SET %s = %s WHERE %s = %s", table, to, d.placeholder(1), from, d.placeholder(2))
+
+	return sel, upd
+}
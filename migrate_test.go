@@ -0,0 +1,25 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestMigrateQueries(t *testing.T) {
+
+	tests := []struct {
+		d        Dialect
+		sel, upd string
+	}{
+		{Postgres, "SELECT id FROM t WHERE id_bin IS NULL AND id IS NOT NULL LIMIT 10", "UPDATE t SET id_bin = $1 WHERE id = $2"},
+		{MySQL, "SELECT id FROM t WHERE id_bin IS NULL AND id IS NOT NULL LIMIT 10", "UPDATE t SET id_bin = ? WHERE id = ?"},
+		{SQLServer, "SELECT TOP 10 id FROM t WHERE id_bin IS NULL AND id IS NOT NULL", "UPDATE t SET id_bin = @p1 WHERE id = @p2"},
+	}
+
+	for _, test := range tests {
+		sel, upd := migrateQueries(test.d, "t", "id", "id_bin", 10)
+
+		if sel != test.sel || upd != test.upd {
+			t.Error("queries should be:", test.sel, test.upd, "got:", sel, upd)
+		}
+	}
+}
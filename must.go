@@ -0,0 +1,27 @@
+package uuid
+
+// Must returns u or panics with err, for wrapping the error returning constructors
+// when initializing package level variables, e.g. var id = uuid.Must(uuid.FromString("..."))
+func Must(u UUID, err error) UUID {
+
+	if err != nil {
+		panic(err)
+	}
+
+	return u
+}
+
+// MustParse is FromString that panics on error
+func MustParse(s string) UUID {
+	return Must(FromString(s))
+}
+
+// MustNewV3 is NewV3 that panics on error
+func MustNewV3(namespace UUID, name string) UUID {
+	return Must(NewV3(namespace, name))
+}
+
+// MustNewV5 is NewV5 that panics on error
+func MustNewV5(namespace UUID, name string) UUID {
+	return Must(NewV5(namespace, name))
+}
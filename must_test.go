@@ -0,0 +1,29 @@
+package uuid
+
+import (
+	"testing"
+)
+
+var mustDNS = MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+func TestMust(t *testing.T) {
+
+	if mustDNS != NamespaceDNS {
+		t.Error("MustParse should be:", NamespaceDNS.String(), "got:", mustDNS.String())
+	}
+
+	v3, _ := NewV3(NamespaceDNS, "name")
+	v5, _ := NewV5(NamespaceDNS, "name")
+
+	if MustNewV3(NamespaceDNS, "name") != v3 || MustNewV5(NamespaceDNS, "name") != v5 {
+		t.Error("MustNewV3 and MustNewV5 should match NewV3 and NewV5")
+	}
+
+	defer func() {
+		if recover() != ErrUUIDFormat {
+			t.Error("MustParse should panic with:", ErrUUIDFormat)
+		}
+	}()
+
+	MustParse("6ba7b810-9dad-91d1-80b4-00c04fd430c8")
+}
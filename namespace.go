@@ -1,39 +1,58 @@
 package uuid
 
+import (
+	"os"
+)
+
+// Namespaces taken from Appendix C
+// https://tools.ietf.org/html/rfc4122#appendix-C
+// They are byte literals so nothing is parsed (or can fail) at init
 var (
-	// DNSNamespace a fully qualified domain name
-	DNSNamespace UUID
+	// NamespaceDNS is a fully qualified domain name, 6ba7b810-9dad-11d1-80b4-00c04fd430c8
+	NamespaceDNS = UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
 
-	// URLNamespace is a URL
-	URLNamespace UUID
+	// NamespaceURL is a URL, 6ba7b811-9dad-11d1-80b4-00c04fd430c8
+	NamespaceURL = UUID{0x6b, 0xa7, 0xb8, 0x11, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
 
-	// IODNamespace is an ISO OID
-	IODNamespace UUID
+	// NamespaceOID is an ISO OID, 6ba7b812-9dad-11d1-80b4-00c04fd430c8
+	NamespaceOID = UUID{0x6b, 0xa7, 0xb8, 0x12, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
 
-	// X500Namespace is an X.500 DN
-	X500Namespace UUID
+	// NamespaceX500 is an X.500 DN, 6ba7b814-9dad-11d1-80b4-00c04fd430c8
+	NamespaceX500 = UUID{0x6b, 0xa7, 0xb8, 0x14, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
 )
 
-// Namespaces taken from Appendix C
-// https://tools.ietf.org/html/rfc4122#appendix-C
-func initNamespace() error {
-	var err error
+// The original names, kept for existing callers
+var (
+	// DNSNamespace is NamespaceDNS
+	DNSNamespace = NamespaceDNS
 
-	if DNSNamespace, err = FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8"); err != nil {
-		return err
-	}
+	// URLNamespace is NamespaceURL
+	URLNamespace = NamespaceURL
 
-	if URLNamespace, err = FromString("6ba7b811-9dad-11d1-80b4-00c04fd430c8"); err != nil {
-		return err
-	}
+	// IODNamespace is NamespaceOID
+	IODNamespace = NamespaceOID
+
+	// X500Namespace is NamespaceX500
+	X500Namespace = NamespaceX500
+)
 
-	if IODNamespace, err = FromString("6ba7b812-9dad-11d1-80b4-00c04fd430c8"); err != nil {
-		return err
+// MachineNamespace returns a namespace that is stable for this host and appName.
+// It is a v5 UUID of appName under a v5 of the hostname in the DNSNamespace. The hostname is
+// the only source, so the namespace does not change with the permissions of the process;
+// it does change if the host is renamed. See SystemUUID for an ID tied to the hardware
+func MachineNamespace(appName string) (UUID, error) {
+
+	name, err := os.Hostname()
+
+	if err != nil {
+		return UUID{}, err
 	}
 
-	if X500Namespace, err = FromString("6ba7b814-9dad-11d1-80b4-00c04fd430c8"); err != nil {
-		return err
+	host, err := NewV5(DNSNamespace, name)
+
+	if err != nil {
+		return UUID{}, err
 	}
 
-	return nil
+	return NewV5(host, appName)
 }
@@ -1,13 +1,61 @@
 package uuid
 
 import (
+	"os"
 	"testing"
 )
 
 // This will test FromString and FromByte
 // but only with cases that should work
 func TestNamespaceInit(t *testing.T) {
-	if err := initNamespace(); err != nil {
+
+	tests := map[string]UUID{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8": NamespaceDNS,
+		"6ba7b811-9dad-11d1-80b4-00c04fd430c8": NamespaceURL,
+		"6ba7b812-9dad-11d1-80b4-00c04fd430c8": NamespaceOID,
+		"6ba7b814-9dad-11d1-80b4-00c04fd430c8": NamespaceX500,
+	}
+
+	for s, ns := range tests {
+		uuid, err := FromString(s)
+
+		if err != nil || uuid != ns {
+			t.Error("namespace should be:", s, "got:", ns.String(), err)
+		}
+	}
+
+	if DNSNamespace != NamespaceDNS || URLNamespace != NamespaceURL || IODNamespace != NamespaceOID || X500Namespace != NamespaceX500 {
+		t.Error("old namespace names should match the new ones")
+	}
+}
+
+func TestMachineNamespace(t *testing.T) {
+
+	ns, err := MachineNamespace("app")
+
+	if err != nil {
 		t.Fatal(err)
 	}
+
+	again, err := MachineNamespace("app")
+
+	if err != nil || again != ns {
+		t.Error("MachineNamespace is not stable", ns.String(), again.String())
+	}
+
+	name, err := os.Hostname()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := MustNewV5(MustNewV5(DNSNamespace, name), "app"); ns != want {
+		t.Error("MachineNamespace should be:", want.String(), "got:", ns.String())
+	}
+
+	other, _ := MachineNamespace("other")
+
+	if other == ns {
+		t.Error("MachineNamespace should differ by app name")
+	}
 }
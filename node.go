@@ -0,0 +1,205 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"net"
+	"os"
+	"strings"
+)
+
+// NodeEnv is the environment variable read for the node ID, e.g. UUID_NODE_ID=02:42:ac:11:00:02
+const NodeEnv = "UUID_NODE_ID"
+
+// NodeSource says where the node ID of v1, v2 and v6 UUIDs came from.
+// Unless an option sets it, the Generator goes down this ladder the first time it needs the node ID
+// (its first v1, v2 or v6, or a call to NodeID or NodeSource) and uses the first that works:
+// the NodeEnv environment variable, the first network interface with a hardware address,
+// a hash of the machine's identity (SystemUUID or /etc/machine-id), and finally random bytes.
+// Hashed and random node IDs have the multicast bit set as RFC4122 Section 4.5 requires,
+// so they can not collide with a real MAC address
+type NodeSource int
+
+const (
+	NodeExplicit    NodeSource = iota + 1 // set with WithNodeID
+	NodeEnvironment                       // read from NodeEnv
+	NodeHardware                          // a network interface's MAC address
+	NodeMachineHash                       // hash of the machine identity
+	NodeRandom                            // random
+	NodeSiteLocal                         // set with WithSiteLocalNode
+)
+
+func (s NodeSource) String() string {
+	switch s {
+	case NodeExplicit:
+		return "explicit"
+	case NodeEnvironment:
+		return "environment"
+	case NodeHardware:
+		return "hardware"
+	case NodeMachineHash:
+		return "machine hash"
+	case NodeRandom:
+		return "random"
+	case NodeSiteLocal:
+		return "site local"
+	}
+	return "unknown"
+}
+
+// WithNodeID sets the node ID of v1, v2 and v6 UUIDs, skipping the NodeSource ladder
+func WithNodeID(node [6]byte) Option {
+	return func(g *Generator) {
+		g.addr, g.nodeSource = node, NodeExplicit
+	}
+}
+
+// WithRandomNode gives the Generator a random node ID with the multicast bit set, as RFC4122 Section 4.5
+// allows, instead of going down the NodeSource ladder. Its UUIDs then say nothing about the host,
+// and containers sharing a made up MAC address no longer share a node. Each Generator gets its own
+func WithRandomNode() Option {
+	return func(g *Generator) {
+		g.addr, g.nodeSource = randomNode(), NodeRandom
+	}
+}
+
+// SetNodeID sets the node ID of the package level constructors, as WithNodeID does for a Generator
+func SetNodeID(node [6]byte) {
+	setDefaultNode(node, NodeExplicit)
+}
+
+// SetRandomNode gives the package level constructors a new random node ID, see WithRandomNode
+func SetRandomNode() {
+	setDefaultNode(randomNode(), NodeRandom)
+}
+
+// setDefaultNode also picks a new clock sequence, as https://tools.ietf.org/html/rfc4122#section-4.1.5
+// advises when the node ID changes
+func setDefaultNode(node [6]byte, source NodeSource) {
+
+	g := defaultGenerator
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addr, g.nodeSource = node, source
+	g.clockSeq = g.randomClockSeq()
+
+	if g.clockSeqSpan > 0 {
+		g.clockSeq = g.clockSeqBase + g.clockSeq%g.clockSeqSpan
+	}
+}
+
+// NodeSource reports where the Generator's node ID came from
+func (g *Generator) NodeSource() NodeSource {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.node()
+	return g.nodeSource
+}
+
+// NodeID returns the node ID the Generator puts in v1, v2 and v6 UUIDs
+func (g *Generator) NodeID() [6]byte {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.node()
+}
+
+// node returns the node ID, going down the NodeSource ladder the first time. It is not done by
+// NewGenerator as the machine hash may run a command (see SystemUUID), and importing the package
+// must not. g.mu must be held, or g not yet shared
+func (g *Generator) node() [6]byte {
+
+	if g.nodeSource == 0 {
+		g.addr, g.nodeSource = resolveNode()
+	}
+
+	return g.addr
+}
+
+// NodeIsRandom reports whether the Generator's node ID is random bytes rather than an address,
+// a hash or a value it was given. Random node IDs have the multicast bit set, see randomNode
+func (g *Generator) NodeIsRandom() bool {
+	return g.NodeSource() == NodeRandom
+}
+
+// NodeIsRandom is Generator.NodeIsRandom for the package level constructors
+func NodeIsRandom() bool {
+	return defaultGenerator.NodeIsRandom()
+}
+
+// DefaultNodeSource reports where the node ID of the package level constructors came from
+func DefaultNodeSource() NodeSource {
+	return defaultGenerator.NodeSource()
+}
+
+// resolveNode goes down the ladder described at NodeSource
+func resolveNode() ([6]byte, NodeSource) {
+
+	if node, ok := envNode(); ok {
+		return node, NodeEnvironment
+	}
+
+	if node, ok := hardwareAddr(); ok {
+		return node, NodeHardware
+	}
+
+	if node, ok := machineNode(); ok {
+		return node, NodeMachineHash
+	}
+
+	return randomNode(), NodeRandom
+}
+
+func envNode() ([6]byte, bool) {
+
+	var node [6]byte
+
+	hw, err := net.ParseMAC(os.Getenv(NodeEnv))
+
+	if err != nil || len(hw) != len(node) {
+		return node, false
+	}
+
+	copy(node[:], hw)
+
+	return node, true
+}
+
+// machineNode hashes the SMBIOS system UUID or, since that usually needs root, the machine-id
+// that systemd and dbus keep stable for the life of the install
+func machineNode() ([6]byte, bool) {
+
+	var node [6]byte
+	var id string
+
+	if sys, err := SystemUUID(); err == nil {
+		id = sys.canonical()
+	} else {
+		for _, path := range []string{"/etc/machine-id", "/var/lib/dbus/machine-id"} {
+			if b, err := os.ReadFile(path); err == nil && len(strings.TrimSpace(string(b))) > 0 {
+				id = strings.TrimSpace(string(b))
+				break
+			}
+		}
+	}
+
+	if id == "" {
+		return node, false
+	}
+
+	sum := sha256.Sum256([]byte("uuid node " + id))
+	copy(node[:], sum[:])
+	node[0] |= 0x01 // multicast bit
+
+	return node, true
+}
+
+// randomNode See https://tools.ietf.org/html/rfc4122#section-4.5
+// The multicast bit (the least significant bit of the first octet, Section 4.1.6) is never
+// set in an address from a network card, so a random node cannot collide with a real one
+func randomNode() [6]byte {
+	var node [6]byte
+	randomBytes(node[:])
+	node[0] |= 0x01 // multicast bit
+	return node
+}
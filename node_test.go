@@ -0,0 +1,130 @@
+package uuid
+
+import (
+	"os"
+	"testing"
+)
+
+func TestNodeLadder(t *testing.T) {
+
+	explicit := [6]byte{0x02, 0x42, 0xac, 0x11, 0x00, 0x02}
+	g := NewGenerator(WithNodeID(explicit))
+
+	if g.NodeSource() != NodeExplicit || g.NodeID() != explicit {
+		t.Error("WithNodeID was not used", g.NodeSource(), g.NodeID())
+	}
+
+	uuid, _ := g.NewV1()
+
+	if [6]byte(uuid[10:]) != explicit {
+		t.Error("v1 does not carry the explicit node", uuid.String())
+	}
+
+	os.Setenv(NodeEnv, "02:42:ac:11:00:03")
+	defer os.Unsetenv(NodeEnv)
+
+	g = NewGenerator()
+
+	if g.NodeSource() != NodeEnvironment || g.NodeID() != [6]byte{0x02, 0x42, 0xac, 0x11, 0x00, 0x03} {
+		t.Error("environment node was not used", g.NodeSource(), g.NodeID())
+	}
+
+	os.Setenv(NodeEnv, "not a mac")
+
+	if g = NewGenerator(); g.NodeSource() == NodeEnvironment {
+		t.Error("bad environment node was used")
+	}
+
+	if node := randomNode(); node[0]&0x01 == 0 {
+		t.Error("random node does not have the multicast bit set")
+	}
+
+	if node, ok := machineNode(); ok && node[0]&0x01 == 0 {
+		t.Error("machine hash node does not have the multicast bit set")
+	}
+}
+
+func TestRandomNode(t *testing.T) {
+
+	a, b := NewGenerator(WithRandomNode()), NewGenerator(WithRandomNode())
+
+	if a.NodeSource() != NodeRandom || a.NodeID()[0]&0x01 == 0 {
+		t.Error("WithRandomNode should give a multicast random node, got:", a.NodeSource(), a.NodeID())
+	}
+
+	if a.NodeID() == b.NodeID() {
+		t.Error("each Generator should get its own random node")
+	}
+}
+
+func TestSetNodeID(t *testing.T) {
+
+	old, source := defaultGenerator.NodeID(), DefaultNodeSource()
+	defer setDefaultNode(old, source)
+
+	node := [6]byte{0x02, 0x42, 0xac, 0x11, 0x00, 0x04}
+	SetNodeID(node)
+
+	if DefaultNodeSource() != NodeExplicit || NewV1().NodeID() != node || NewV6().NodeID() != node {
+		t.Error("package level constructors should use the node set, got:", DefaultNodeSource(), NewV1().NodeID())
+	}
+
+	SetRandomNode()
+
+	if random := NewV1().NodeID(); DefaultNodeSource() != NodeRandom || random == node || random[0]&0x01 == 0 {
+		t.Error("package level constructors should use a random multicast node, got:", DefaultNodeSource(), random)
+	}
+}
+
+func TestNodeIsRandom(t *testing.T) {
+
+	if g := NewGenerator(WithRandomNode()); !g.NodeIsRandom() || g.NodeID()[0]&0x01 == 0 {
+		t.Error("random node should be reported random, got:", g.NodeSource(), g.NodeID())
+	}
+
+	if g := NewGenerator(WithNodeID([6]byte{0x01})); g.NodeIsRandom() {
+		t.Error("explicit node should not be reported random, even with the multicast bit")
+	}
+
+	if g := NewGenerator(WithSiteLocalNode([]byte("salt"))); g.NodeIsRandom() != (g.NodeSource() == NodeRandom) {
+		t.Error("site local node should only be random when the hostname is missing")
+	}
+
+	old, source := defaultGenerator.NodeID(), DefaultNodeSource()
+	defer setDefaultNode(old, source)
+
+	SetRandomNode()
+
+	if !NodeIsRandom() {
+		t.Error("package level node should be random after SetRandomNode")
+	}
+
+	SetNodeID(old)
+
+	if NodeIsRandom() {
+		t.Error("package level node should not be random after SetNodeID")
+	}
+}
+
+func TestNodeLazy(t *testing.T) {
+
+	g := NewGenerator()
+
+	if g.nodeSource != 0 {
+		t.Fatal("NewGenerator should not resolve the node ID, got:", g.nodeSource)
+	}
+
+	uuid, err := g.NewV1()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g.nodeSource == 0 || uuid.NodeID() != g.NodeID() {
+		t.Error("the first v1 should resolve the node ID, got:", g.NodeSource(), uuid.NodeID())
+	}
+
+	if f := NewGenerator(); f.NodeSource() == 0 {
+		t.Error("NodeSource should resolve the node ID")
+	}
+}
@@ -0,0 +1,52 @@
+package uuid
+
+import (
+	"encoding/base64"
+)
+
+// Active Directory stores objectGUID in the Microsoft GUID layout:
+// time_low, time_mid and time_hi_and_version are little endian while the rest is big endian
+// https://docs.microsoft.com/en-us/windows/win32/api/guiddef/ns-guiddef-guid
+func swapGUID(dst, src []byte) {
+	dst[0], dst[1], dst[2], dst[3] = src[3], src[2], src[1], src[0]
+	dst[4], dst[5] = src[5], src[4]
+	dst[6], dst[7] = src[7], src[6]
+	copy(dst[8:], src[8:16])
+}
+
+// FromObjectGUID converts the raw 16 byte objectGUID attribute into a UUID
+func FromObjectGUID(b []byte) (UUID, error) {
+
+	if len(b) != uuidSize {
+		return UUID{}, ErrUUIDSize
+	}
+
+	var r [uuidSize]byte
+	swapGUID(r[:], b)
+
+	return FromBytes(r[:])
+}
+
+// FromObjectGUIDBase64 converts the base64 objectGUID found in LDIF exports (objectGUID:: ...)
+func FromObjectGUIDBase64(s string) (UUID, error) {
+
+	b, err := base64.StdEncoding.DecodeString(s)
+
+	if err != nil {
+		return UUID{}, err
+	}
+
+	return FromObjectGUID(b)
+}
+
+// ToObjectGUID returns the UUID in the byte order Active Directory stores objectGUID
+func (u UUID) ToObjectGUID() []byte {
+	b := make([]byte, uuidSize)
+	swapGUID(b, u[:])
+	return b
+}
+
+// ObjectGUIDBase64 returns the objectGUID as it would appear in an LDIF export
+func (u UUID) ObjectGUIDBase64() string {
+	return base64.StdEncoding.EncodeToString(u.ToObjectGUID())
+}
@@ -0,0 +1,37 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestObjectGUID(t *testing.T) {
+
+	uuid, err := FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []byte{0x10, 0xb8, 0xa7, 0x6b, 0xad, 0x9d, 0xd1, 0x11, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	if !bytes.Equal(uuid.ToObjectGUID(), want) {
+		t.Error("ToObjectGUID is", uuid.ToObjectGUID(), "should be:", want)
+	}
+
+	back, err := FromObjectGUID(want)
+
+	if err != nil || back != uuid {
+		t.Error("FromObjectGUID did not round trip", back.String(), err)
+	}
+
+	back, err = FromObjectGUIDBase64(uuid.ObjectGUIDBase64())
+
+	if err != nil || back != uuid {
+		t.Error("FromObjectGUIDBase64 did not round trip", back.String(), err)
+	}
+
+	if _, err = FromObjectGUID(want[:10]); err != ErrUUIDSize {
+		t.Error("FromObjectGUID did not detect wrong length")
+	}
+}
@@ -0,0 +1,159 @@
+package uuid
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrPoolClosed is returned by a Pool's Get calls once it is closed and empty
+var ErrPoolClosed = errors.New("UUID pool is closed")
+
+// PoolStats counts how often a Pool could not serve a Get from what it had pre-generated
+type PoolStats struct {
+	BulkWaits      uint64 // Gets that found the bulk lane empty and waited for the refill
+	PriorityMisses uint64 // GetPriority calls that found both lanes empty and generated inline
+}
+
+// Pool pre-generates UUIDs in the background so Get does not pay for generation (or for reading
+// entropy) on the hot path. It has two lanes: Get takes from the bulk lane and waits for the refill
+// when it is empty, while GetPriority takes from a reserve that Get never touches, then from the bulk
+// lane, and generates inline rather than wait. Latency critical callers using GetPriority never
+// queue behind batch consumers draining the bulk lane; the refill tops the reserve up first
+type Pool struct {
+	gen      func() (UUID, error)
+	bulk     chan UUID
+	priority chan UUID
+
+	stop      chan struct{}
+	done      chan struct{} // closed when the refill has stopped, err says why
+	closeOnce sync.Once
+	err       error
+
+	bulkWaits      atomic.Uint64
+	priorityMisses atomic.Uint64
+}
+
+// NewPool starts a Pool holding up to size UUIDs in the bulk lane and reserve in the priority lane,
+// made with gen (e.g. NewV4 wrapped, or a Generator's NewV4). gen must be safe for concurrent use
+// since GetPriority may call it inline. An error from gen stops the refill; Gets return it once
+// the lanes are empty
+func NewPool(size, reserve int, gen func() (UUID, error)) *Pool {
+
+	p := &Pool{
+		gen:      gen,
+		bulk:     make(chan UUID, size),
+		priority: make(chan UUID, reserve),
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+	}
+
+	go p.refill()
+
+	return p
+}
+
+func (p *Pool) refill() {
+
+	defer close(p.done)
+
+	for {
+		uuid, err := p.gen()
+
+		if err != nil {
+			p.err = err
+			return
+		}
+
+		select {
+		case p.priority <- uuid: // the reserve first
+			continue
+		default:
+		}
+
+		select {
+		case p.priority <- uuid:
+		case p.bulk <- uuid:
+		case <-p.stop:
+			p.err = ErrPoolClosed
+			return
+		}
+	}
+}
+
+// Get returns a UUID from the bulk lane, waiting for the refill if it is empty
+func (p *Pool) Get() (UUID, error) {
+	return p.GetContext(context.Background())
+}
+
+// GetContext is Get that gives up when ctx is done, returning ctx.Err(), so request handlers
+// can fail fast when the pool is drained and the refill is slow (e.g. waiting on entropy)
+func (p *Pool) GetContext(ctx context.Context) (UUID, error) {
+
+	select {
+	case uuid := <-p.bulk:
+		return uuid, nil
+	default:
+	}
+
+	p.bulkWaits.Add(1)
+
+	select {
+	case uuid := <-p.bulk:
+		return uuid, nil
+	case <-p.done:
+		return p.drained()
+	case <-ctx.Done():
+		return UUID{}, ctx.Err()
+	}
+}
+
+// GetPriority returns a UUID from the reserve, or the bulk lane, or generates one inline.
+// It never waits for the refill
+func (p *Pool) GetPriority() (UUID, error) {
+
+	select {
+	case uuid := <-p.priority:
+		return uuid, nil
+	default:
+	}
+
+	select {
+	case uuid := <-p.bulk:
+		return uuid, nil
+	case <-p.done:
+		return p.drained()
+	default:
+	}
+
+	p.priorityMisses.Add(1)
+
+	return p.gen()
+}
+
+// drained serves what is left once the refill has stopped, then its error
+func (p *Pool) drained() (UUID, error) {
+
+	select {
+	case uuid := <-p.bulk:
+		return uuid, nil
+	case uuid := <-p.priority:
+		return uuid, nil
+	default:
+	}
+
+	return UUID{}, p.err
+}
+
+// Stats returns the starvation counters, for metrics. Rising BulkWaits means the pool is too small
+// for the bulk consumers; any PriorityMisses means the reserve is
+func (p *Pool) Stats() PoolStats {
+	return PoolStats{BulkWaits: p.bulkWaits.Load(), PriorityMisses: p.priorityMisses.Load()}
+}
+
+// Close stops the refill. UUIDs already in the pool can still be taken, then Gets return ErrPoolClosed
+func (p *Pool) Close() {
+	p.closeOnce.Do(func() { close(p.stop) })
+	<-p.done
+}
@@ -0,0 +1,108 @@
+package uuid
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestPool(t *testing.T) {
+
+	g := NewGenerator()
+	p := NewPool(64, 8, g.NewV4)
+	seen := make(map[UUID]bool, testSize)
+
+	for i := 0; i < testSize; i++ {
+		get := p.Get
+
+		if i%3 == 0 {
+			get = p.GetPriority
+		}
+
+		uuid, err := get()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if seen[uuid] || !uuidRegex.MatchString(uuid.String()) {
+			t.Fatal("Pool gave a duplicate or bad UUID", uuid.String())
+		}
+
+		seen[uuid] = true
+	}
+
+	p.Close()
+
+	for i := 0; i < 64+8+1; i++ {
+		if _, err := p.Get(); err != nil {
+			if err != ErrPoolClosed {
+				t.Error("closed pool should be:", ErrPoolClosed, "got:", err)
+			}
+
+			return
+		}
+	}
+
+	t.Error("closed pool kept giving UUIDs")
+}
+
+func TestPoolPriority(t *testing.T) {
+
+	// a pool without any room makes every Get wait and every GetPriority generate inline
+	p := NewPool(0, 0, NewGenerator().NewV4)
+	defer p.Close()
+
+	if _, err := p.GetPriority(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := p.Get(); err != nil {
+		t.Fatal(err)
+	}
+
+	if s := p.Stats(); s.BulkWaits == 0 {
+		t.Error("Get on an empty pool should count a wait", s)
+	}
+}
+
+func TestPoolError(t *testing.T) {
+
+	p := NewPool(4, 1, NewGenerator(WithReadOnly()).NewV4)
+
+	if _, err := p.Get(); err != ErrReadOnly {
+		t.Error("Get should be:", ErrReadOnly, "got:", err)
+	}
+
+	if _, err := p.GetPriority(); err != ErrReadOnly {
+		t.Error("GetPriority should be:", ErrReadOnly, "got:", err)
+	}
+
+	p.Close()
+}
+
+func TestPoolGetContext(t *testing.T) {
+
+	// the refill blocks, as if waiting on entropy, until the test lets it go
+	release := make(chan struct{})
+
+	p := NewPool(4, 0, func() (UUID, error) {
+		<-release
+		return NewV4(), nil
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := p.GetContext(ctx); err != context.DeadlineExceeded {
+		t.Error("GetContext should be:", context.DeadlineExceeded, "got:", err)
+	}
+
+	close(release)
+
+	if _, err := p.GetContext(context.Background()); err != nil {
+		t.Error(err)
+	}
+
+	p.Close()
+}
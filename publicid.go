@@ -0,0 +1,208 @@
+package uuid
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"sync"
+)
+
+const (
+	publicIDTagSize = 4 // number of MAC bytes appended to the encrypted UUID
+	publicIDSize    = 1 + uuidSize + publicIDTagSize
+)
+
+var (
+	// ErrPublicID is returned when a public ID was not made by one of the keyring's keys or was altered
+	ErrPublicID = errors.New("public ID is not valid")
+)
+
+// Keyring supplies the keys of a PublicIDCodec. Keys are identified by a byte that is stored in
+// every public ID, so IDs minted under an old key keep decoding as long as Key still returns it
+type Keyring interface {
+	// Current returns the key new public IDs are minted with
+	Current() (id byte, key []byte)
+
+	// Key returns the key with the given id, or false if the keyring does not have it
+	Key(id byte) ([]byte, bool)
+}
+
+// StaticKeyring is a Keyring held in memory. The fields set the starting keys and must not be
+// changed once a codec is using the keyring; rotate with Rotate and Retire, which are safe to
+// call while public IDs are encoded and decoded
+type StaticKeyring struct {
+	CurrentID byte
+	Keys      map[byte][]byte
+
+	mu sync.RWMutex
+}
+
+// Current see Keyring
+func (k *StaticKeyring) Current() (byte, []byte) {
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	return k.CurrentID, k.Keys[k.CurrentID]
+}
+
+// Key see Keyring
+func (k *StaticKeyring) Key(id byte) ([]byte, bool) {
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	key, ok := k.Keys[id]
+
+	return key, ok
+}
+
+// Rotate adds key under id and makes it the key new public IDs are minted with.
+// IDs minted under the other keys keep decoding until those are retired
+func (k *StaticKeyring) Rotate(id byte, key []byte) {
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if k.Keys == nil {
+		k.Keys = make(map[byte][]byte)
+	}
+
+	k.Keys[id] = key
+	k.CurrentID = id
+}
+
+// Retire removes the key with the given id, so public IDs minted under it stop decoding
+func (k *StaticKeyring) Retire(id byte) {
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	delete(k.Keys, id)
+}
+
+// PublicIDCodec turns UUIDs into non-enumerable public identifiers and back.
+// A UUID is exactly one AES block, so it is encrypted as a single block (a keyed permutation,
+// the same UUID always gives the same ID) and a 4 byte HMAC-SHA256 tag over the key id and
+// ciphertext is appended so tampered or made-up IDs are rejected.
+// The result is 28 characters of unpadded base64url
+type PublicIDCodec struct {
+	keys  Keyring
+	cache sync.Map // key id to *publicIDKey
+}
+
+type publicIDKey struct {
+	key   []byte // the keyring key these were derived from
+	block cipher.Block
+	mac   []byte
+}
+
+// NewPublicIDCodec creates a codec from a single secret service key of at least 16 bytes
+// It is a keyring holding only key id 0; see NewPublicIDCodecKeyring for rotation
+func NewPublicIDCodec(key []byte) (*PublicIDCodec, error) {
+	return NewPublicIDCodecKeyring(&StaticKeyring{Keys: map[byte][]byte{0: key}})
+}
+
+// NewPublicIDCodecKeyring creates a codec that mints with the keyring's current key and decodes
+// with whichever key the public ID names. Keys must be at least 16 bytes
+func NewPublicIDCodecKeyring(keys Keyring) (*PublicIDCodec, error) {
+
+	c := &PublicIDCodec{keys: keys}
+
+	if _, err := c.key(keys.Current()); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// key returns the cipher and MAC key derived from key, which is the keyring's key for id.
+// Separate encryption and MAC keys are derived with HMAC-SHA256
+func (c *PublicIDCodec) key(id byte, key []byte) (*publicIDKey, error) {
+
+	if k, ok := c.cache.Load(id); ok && hmac.Equal(k.(*publicIDKey).key, key) {
+		return k.(*publicIDKey), nil
+	}
+
+	if len(key) < 16 {
+		return nil, aes.KeySizeError(len(key))
+	}
+
+	block, err := aes.NewCipher(deriveKey(key, "uuid public id encryption"))
+
+	if err != nil {
+		return nil, err
+	}
+
+	k := &publicIDKey{key: key, block: block, mac: deriveKey(key, "uuid public id mac")}
+	c.cache.Store(id, k)
+
+	return k, nil
+}
+
+func deriveKey(key []byte, label string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(label))
+	return h.Sum(nil)
+}
+
+func (k *publicIDKey) tag(b []byte) []byte {
+	h := hmac.New(sha256.New, k.mac)
+	h.Write(b)
+	return h.Sum(nil)[:publicIDTagSize]
+}
+
+// Encode returns the public ID of u under the keyring's current key
+func (c *PublicIDCodec) Encode(u UUID) (string, error) {
+
+	id, key := c.keys.Current()
+	k, err := c.key(id, key)
+
+	if err != nil {
+		return "", err
+	}
+
+	b := make([]byte, 1+uuidSize, publicIDSize)
+
+	b[0] = id
+	k.block.Encrypt(b[1:], u[:])
+	b = append(b, k.tag(b)...)
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Decode returns the UUID behind a public ID made by Encode under any key still in the keyring
+func (c *PublicIDCodec) Decode(s string) (UUID, error) {
+
+	var uuid UUID
+
+	b, err := base64.RawURLEncoding.DecodeString(s)
+
+	if err != nil || len(b) != publicIDSize {
+		return uuid, ErrPublicID
+	}
+
+	key, ok := c.keys.Key(b[0])
+
+	if !ok {
+		return uuid, ErrPublicID
+	}
+
+	k, err := c.key(b[0], key)
+
+	if err != nil {
+		return uuid, err
+	}
+
+	if subtle.ConstantTimeCompare(k.tag(b[:1+uuidSize]), b[1+uuidSize:]) != 1 {
+		return uuid, ErrPublicID
+	}
+
+	k.block.Decrypt(uuid[:], b[1:1+uuidSize])
+
+	return uuid, nil
+}
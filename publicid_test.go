@@ -0,0 +1,122 @@
+package uuid
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestPublicIDCodec(t *testing.T) {
+
+	c, err := NewPublicIDCodec([]byte("0123456789abcdef0123456789abcdef"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uuid := NewV4()
+	id, err := c.Encode(uuid)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(id) != 28 {
+		t.Error("public ID is", len(id), "characters", id)
+	}
+
+	if again, _ := c.Encode(uuid); again != id {
+		t.Error("public ID is not deterministic")
+	}
+
+	back, err := c.Decode(id)
+
+	if err != nil || back != uuid {
+		t.Error("public ID did not round trip", id, err)
+	}
+
+	other, _ := NewPublicIDCodec([]byte("another key of at least 16 bytes"))
+
+	if _, err = other.Decode(id); err != ErrPublicID {
+		t.Error("public ID decoded under a different key")
+	}
+
+	tampered := []byte(id)
+	tampered[5] ^= 1
+
+	if _, err = c.Decode(string(tampered)); err != ErrPublicID {
+		t.Error("tampered public ID decoded", string(tampered))
+	}
+
+	if _, err = NewPublicIDCodec([]byte("short")); err == nil {
+		t.Error("NewPublicIDCodec accepted a short key")
+	}
+}
+
+func TestPublicIDKeyRotation(t *testing.T) {
+
+	keys := &StaticKeyring{CurrentID: 1, Keys: map[byte][]byte{1: []byte("first key, 16 bytes or more")}}
+	c, err := NewPublicIDCodecKeyring(keys)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uuid := NewV4()
+	old, _ := c.Encode(uuid)
+
+	keys.Rotate(2, []byte("second key, 16 bytes or more"))
+
+	current, _ := c.Encode(uuid)
+
+	if current == old {
+		t.Error("public ID did not change after rotating keys")
+	}
+
+	for _, id := range []string{old, current} {
+		if back, err := c.Decode(id); err != nil || back != uuid {
+			t.Error("public ID did not decode after rotation", id, err)
+		}
+	}
+
+	keys.Retire(1)
+
+	if _, err = c.Decode(old); err != ErrPublicID {
+		t.Error("public ID decoded under a retired key")
+	}
+}
+
+func TestPublicIDKeyRotationConcurrent(t *testing.T) {
+
+	keys := &StaticKeyring{Keys: map[byte][]byte{0: []byte("first key, 16 bytes or more")}}
+	c, err := NewPublicIDCodecKeyring(keys)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for n := 0; n < 1000; n++ {
+				uuid := NewV4()
+				id, _ := c.Encode(uuid)
+
+				if back, err := c.Decode(id); err != nil || back != uuid {
+					t.Error("public ID did not round trip during rotation", err)
+					return
+				}
+			}
+		}()
+	}
+
+	for id := byte(1); id < 50; id++ {
+		keys.Rotate(id, []byte("rotated key, 16 bytes or more"))
+	}
+
+	wg.Wait()
+}
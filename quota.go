@@ -0,0 +1,82 @@
+package uuid
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrQuotaExceeded is returned by a Generator with WithQuota that has used up its current window
+var ErrQuotaExceeded = errors.New("uuid quota exceeded")
+
+// quota counts the UUIDs of the current window. It has its own lock as v4s are made without g.mu
+type quota struct {
+	mu    sync.Mutex
+	n     int
+	per   time.Duration
+	start time.Time // when the current window began, zero before the first UUID
+	used  int
+}
+
+// WithQuota lets the Generator create at most n UUIDs per window, for APIs where every ID minted
+// stands for a resource created. A window starts with the first UUID after the last one ended and
+// is timed on the Generator's clock. Past the quota every New call returns ErrQuotaExceeded, without
+// touching the clock sequence or counters, until the window ends. A batch counts as its size and
+// fails whole if it does not fit. A call that fails for another reason, such as ErrTimestampRange
+// or ErrInconsistent, does not count. Every Generator the option is applied to shares the one quota,
+// as does a Fork, so neither a GeneratorGroup nor forking raises the cap
+func WithQuota(n int, per time.Duration) Option {
+
+	q := &quota{n: n, per: per}
+
+	return func(g *Generator) {
+		g.quota = q
+	}
+}
+
+// allow is the first step of every constructor: it refuses for a read only Generator
+// and when k more UUIDs would not fit the quota. Nothing is taken until spend
+func (g *Generator) allow(k int) error {
+
+	if g.readOnly {
+		return ErrReadOnly
+	}
+
+	if g.quota == nil {
+		return nil
+	}
+
+	return g.quota.take(g.now(), k, false)
+}
+
+// spend takes k UUIDs from the quota once every other check has passed. It still fails if
+// another call took the room since allow
+func (g *Generator) spend(k int) error {
+
+	if g.quota == nil {
+		return nil
+	}
+
+	return g.quota.take(g.now(), k, true)
+}
+
+// take checks that k UUIDs fit the window at now, and counts them when commit is set
+func (q *quota) take(now time.Time, k int, commit bool) error {
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.start.IsZero() || now.Sub(q.start) >= q.per {
+		q.start, q.used = now, 0
+	}
+
+	if q.used+k > q.n {
+		return ErrQuotaExceeded
+	}
+
+	if commit {
+		q.used += k
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWithQuota(t *testing.T) {
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	g := NewGenerator(WithClock(clockFunc(func() time.Time { return now })), WithQuota(3, time.Minute))
+
+	for _, gen := range []func() (UUID, error){g.NewV1, g.NewV4, g.NewV7} {
+		if _, err := gen(); err != nil {
+			t.Fatal("inside the quota:", err)
+		}
+	}
+
+	for _, gen := range []func() (UUID, error){g.NewV1, g.NewV4, g.NewV6, g.NewV7} {
+		if _, err := gen(); err != ErrQuotaExceeded {
+			t.Error("past the quota should be:", ErrQuotaExceeded, "got:", err)
+		}
+	}
+
+	if _, err := g.NewV2(DomainPerson, 0); err != ErrQuotaExceeded {
+		t.Error("v2 past the quota should be:", ErrQuotaExceeded, "got:", err)
+	}
+
+	now = now.Add(59 * time.Second)
+
+	if _, err := g.NewV4(); err != ErrQuotaExceeded {
+		t.Error("the window should not have ended, got:", err)
+	}
+
+	now = now.Add(time.Second)
+
+	if _, err := g.NewV4Batch(4); err != ErrQuotaExceeded {
+		t.Error("a batch larger than the quota should fail whole, got:", err)
+	}
+
+	if uuids, err := g.NewV4Batch(3); err != nil || len(uuids) != 3 {
+		t.Error("a new window should allow a batch of the quota, got:", err)
+	}
+
+	f := g.Fork()
+
+	if _, err := f.NewV4(); err != ErrQuotaExceeded {
+		t.Error("a fork should share the quota, got:", err)
+	}
+
+	if _, err := NewGenerator(WithReadOnly(), WithQuota(1, time.Minute)).NewV4(); err != ErrReadOnly {
+		t.Error("read only should come before the quota, got:", err)
+	}
+}
+
+func TestWithQuotaFailures(t *testing.T) {
+
+	g := NewGenerator(WithQuota(1, time.Hour))
+
+	if _, err := g.NewV7WithTime(FixedClock(time.Unix(-1, 0))); err != ErrTimestampRange {
+		t.Fatal("v7 before 1970 should be:", ErrTimestampRange, "got:", err)
+	}
+
+	if _, err := g.NewV1WithTime(FixedClock(time.Date(1500, 1, 1, 0, 0, 0, 0, time.UTC))); err != ErrTimestampRange {
+		t.Fatal("v1 before 1582 should be:", ErrTimestampRange, "got:", err)
+	}
+
+	if _, err := g.NewV4(); err != nil {
+		t.Error("failed calls should not count against the quota, got:", err)
+	}
+}
+
+func TestWithQuotaFillV4(t *testing.T) {
+
+	g := NewGenerator(WithQuota(4, time.Hour), WithStrictOutput())
+	dst := make([]UUID, 3)
+
+	if err := g.FillV4(dst); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := g.FillV4(dst); err != ErrQuotaExceeded {
+		t.Error("a batch past the quota should be:", ErrQuotaExceeded, "got:", err)
+	}
+
+	if _, err := g.NewV4(); err != nil {
+		t.Error("a failed batch should not count, got:", err)
+	}
+
+	if _, err := g.NewV4(); err != ErrQuotaExceeded {
+		t.Error("FillV4 and NewV4 should share the quota, got:", err)
+	}
+}
@@ -0,0 +1,38 @@
+package uuid
+
+import "sync"
+
+// randPoolSize is how many crypto/rand bytes one read puts in a pooled buffer
+const randPoolSize = 4096
+
+// randBuffer is crypto/rand output waiting to be used. Bytes before off have been handed out
+type randBuffer struct {
+	b   [randPoolSize]byte
+	off int
+}
+
+// randPool keeps a buffer per P, so goroutines creating v4s neither wait on each other nor make a
+// getrandom call per UUID
+var randPool = sync.Pool{
+	New: func() any {
+		return &randBuffer{off: randPoolSize}
+	},
+}
+
+// pooledRandom fills b from a pooled buffer of crypto/rand output, refilling it when it runs low.
+// b must not be longer than randPoolSize. The bytes handed out are cleared from the buffer
+func pooledRandom(b []byte) {
+
+	r := randPool.Get().(*randBuffer)
+
+	if r.off+len(b) > len(r.b) {
+		readRandom(nil, r.b[:])
+		r.off = 0
+	}
+
+	n := copy(b, r.b[r.off:])
+	clear(r.b[r.off : r.off+n])
+	r.off += n
+
+	randPool.Put(r)
+}
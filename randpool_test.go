@@ -0,0 +1,50 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPooledRandom(t *testing.T) {
+
+	seen := make(map[[8]byte]bool)
+
+	// more than one buffer's worth, so it is refilled
+	for i := 0; i < 3*randPoolSize/8; i++ {
+		var b [8]byte
+		pooledRandom(b[:])
+
+		if seen[b] {
+			t.Fatal("pooledRandom repeated", b)
+		}
+
+		seen[b] = true
+	}
+
+	r := randPool.Get().(*randBuffer)
+	defer randPool.Put(r)
+
+	if !bytes.Equal(r.b[:r.off], make([]byte, r.off)) {
+		t.Error("bytes handed out should be cleared from the buffer")
+	}
+}
+
+func TestNewV4RandSource(t *testing.T) {
+
+	defer SetRandSource(nil)
+
+	SetRandSource(bytes.NewReader(bytes.Repeat([]byte{0xAB}, 32)))
+	uuid := NewV4()
+
+	if uuid.String() != "abababab-abab-4bab-abab-abababababab" {
+		t.Error("NewV4 should read from the rand source, got:", uuid)
+	}
+}
+
+func BenchmarkV4Parallel(b *testing.B) {
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			devNull(NewV4())
+		}
+	})
+}
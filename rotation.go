@@ -0,0 +1,31 @@
+package uuid
+
+// NodeSlot is one node ID and clock sequence range a Generator can rotate through
+// ClockSeqBase and ClockSeqSpan must keep the range below 16384 (the clock sequence is 14 bits)
+// and must not overlap other slots with the same node. A span of 0 is the whole clock sequence
+type NodeSlot struct {
+	Node         [6]byte
+	ClockSeqBase uint16
+	ClockSeqSpan uint16
+
+	clockSeq  uint16
+	lastTime  uint64 // see Generator.lastTime
+	lastClock uint64
+}
+
+// WithNodeRotation makes v1, v2 and v6 UUIDs cycle through the slots round robin, each with its
+// own clock sequence. CI farms can give every ephemeral runner a disjoint set of slots instead of
+// hundreds of runners sharing the same randomized node bytes
+func WithNodeRotation(slots []NodeSlot) Option {
+	return func(g *Generator) {
+
+		if len(slots) == 0 {
+			return
+		}
+
+		g.rotation = make([]NodeSlot, len(slots))
+		copy(g.rotation, slots) // clock sequences are set by NewGenerator
+
+		g.addr, g.nodeSource = g.rotation[0].Node, NodeExplicit
+	}
+}
@@ -0,0 +1,36 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+func TestNodeRotation(t *testing.T) {
+
+	slots := []NodeSlot{
+		{Node: [6]byte{0x03, 0, 0, 0, 0, 1}, ClockSeqBase: 0, ClockSeqSpan: 100},
+		{Node: [6]byte{0x03, 0, 0, 0, 0, 2}, ClockSeqBase: 100, ClockSeqSpan: 100},
+		{Node: [6]byte{0x03, 0, 0, 0, 0, 3}, ClockSeqBase: 200, ClockSeqSpan: 100},
+	}
+
+	g := NewGenerator(WithNodeRotation(slots))
+
+	for i := 0; i < 3000; i++ {
+		uuid, err := g.NewV1()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		s := slots[i%len(slots)]
+		cs := binary.BigEndian.Uint16(uuid[8:]) & 0x3FFF
+
+		if [6]byte(uuid[10:]) != s.Node {
+			t.Fatal("v1", i, "does not use slot", i%len(slots), uuid.String())
+		}
+
+		if cs < s.ClockSeqBase || cs >= s.ClockSeqBase+s.ClockSeqSpan {
+			t.Fatal("v1", i, "clock sequence", cs, "is outside its slot")
+		}
+	}
+}
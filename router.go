@@ -0,0 +1,174 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"slices"
+	"sync"
+)
+
+const maxPrefixBits = 16 // 65536 partitions
+
+// PrefixRouter maps UUIDs to owners (storage nodes, shards...) by their first bits. Each of the
+// 2^bits prefixes is a partition, given to the owner with the highest rendezvous hash for it, so
+// every process that adds the same owners builds the same table, whatever the order. Adding an
+// owner only moves the partitions it wins and removing one only moves its own.
+// Prefixes are only uniform for random bits: those of v1 are time_low, which is fine, but v6 and v7
+// start with a timestamp, so recent UUIDs share a prefix and should be routed by another key
+type PrefixRouter struct {
+	mu     sync.RWMutex
+	bits   int
+	owners []string // sorted
+	table  []int    // owner index per partition, -1 when there are no owners
+}
+
+// RebalanceStats tells how much of the table an Add or Remove moved
+type RebalanceStats struct {
+	Moved      int // partitions that changed owner
+	Partitions int // partitions in the table, 2^bits
+	Owners     int // owners after the change
+}
+
+// Fraction is the share of the partitions that moved, 1/Owners when the hashing is even
+func (s RebalanceStats) Fraction() float64 {
+	return float64(s.Moved) / float64(s.Partitions)
+}
+
+// NewPrefixRouter creates an empty router on the first prefBits bits of the UUID.
+// prefBits is kept between 1 and 16
+func NewPrefixRouter(prefBits int) *PrefixRouter {
+
+	prefBits = min(max(prefBits, 1), maxPrefixBits)
+
+	r := &PrefixRouter{bits: prefBits, table: make([]int, 1<<prefBits)}
+
+	for i := range r.table {
+		r.table[i] = -1
+	}
+
+	return r
+}
+
+// Prefix returns the partition of u, its first bits as a number
+func (r *PrefixRouter) Prefix(u UUID) int {
+	return int(binary.BigEndian.Uint32(u[0:]) >> (32 - r.bits))
+}
+
+// Owner returns the owner of u's partition, false while the router has no owners
+func (r *PrefixRouter) Owner(u UUID) (string, bool) {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	i := r.table[r.Prefix(u)]
+
+	if i < 0 {
+		return "", false
+	}
+
+	return r.owners[i], true
+}
+
+// Owners returns the owners in sorted order
+func (r *PrefixRouter) Owners() []string {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return slices.Clone(r.owners)
+}
+
+// Partitions returns how many partitions each owner has
+func (r *PrefixRouter) Partitions() map[string]int {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	counts := make(map[string]int, len(r.owners))
+
+	for _, i := range r.table {
+		if i >= 0 {
+			counts[r.owners[i]]++
+		}
+	}
+
+	return counts
+}
+
+// Add adds owner and gives it the partitions it wins. Adding an owner twice moves nothing
+func (r *PrefixRouter) Add(owner string) RebalanceStats {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i, ok := slices.BinarySearch(r.owners, owner)
+
+	if ok {
+		return r.stats(0)
+	}
+
+	return r.rebuild(slices.Insert(slices.Clone(r.owners), i, owner))
+}
+
+// Remove takes owner out and hands its partitions to the others. Unknown owners move nothing
+func (r *PrefixRouter) Remove(owner string) RebalanceStats {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	i, ok := slices.BinarySearch(r.owners, owner)
+
+	if !ok {
+		return r.stats(0)
+	}
+
+	return r.rebuild(slices.Delete(slices.Clone(r.owners), i, i+1))
+}
+
+// rebuild assigns every partition for owners and counts the ones whose owner changed. r.mu must be held
+func (r *PrefixRouter) rebuild(owners []string) RebalanceStats {
+
+	seeds := make([]uint64, len(owners))
+
+	for i, owner := range owners {
+		h := fnv.New64a()
+		h.Write([]byte(owner))
+		seeds[i] = h.Sum64()
+	}
+
+	moved := 0
+
+	for p := range r.table {
+		best, bestScore := -1, uint64(0)
+
+		for i, seed := range seeds {
+			if score := mix64(seed ^ uint64(p)); best < 0 || score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+
+		if old := r.table[p]; old < 0 || best < 0 || r.owners[old] != owners[best] {
+			moved++
+		}
+
+		r.table[p] = best
+	}
+
+	r.owners = owners
+
+	return r.stats(moved)
+}
+
+func (r *PrefixRouter) stats(moved int) RebalanceStats {
+	return RebalanceStats{Moved: moved, Partitions: len(r.table), Owners: len(r.owners)}
+}
+
+// mix64 is the splitmix64 finalizer, it spreads the close inputs of one owner's partitions
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,113 @@
+package uuid
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPrefixRouter(t *testing.T) {
+
+	r := NewPrefixRouter(12)
+
+	if _, ok := r.Owner(NewV4()); ok {
+		t.Error("empty router should have no owner")
+	}
+
+	if s := r.Add("node-0"); s.Moved != 4096 || s.Partitions != 4096 || s.Owners != 1 {
+		t.Error("first owner should take every partition, got:", s)
+	}
+
+	for i := 1; i < 8; i++ {
+		s := r.Add(fmt.Sprint("node-", i))
+
+		// an even share is 1/(i+1) of the table
+		if want := 4096 / (i + 1); s.Moved < want*3/4 || s.Moved > want*5/4 {
+			t.Error("adding owner", i, "should move about:", want, "got:", s.Moved)
+		}
+	}
+
+	for owner, n := range r.Partitions() {
+		if n < 512*3/4 || n > 512*5/4 {
+			t.Error(owner, "should have about 512 partitions, got:", n)
+		}
+	}
+
+	if s := r.Add("node-3"); s.Moved != 0 {
+		t.Error("adding an owner twice should move nothing, got:", s)
+	}
+
+	before := make(map[int]string)
+
+	for p := 0; p < 4096; p++ {
+		u := UUID{byte(p >> 4), byte(p << 4)}
+		before[p], _ = r.Owner(u)
+
+		if r.Prefix(u) != p {
+			t.Fatal("prefix should be:", p, "got:", r.Prefix(u))
+		}
+	}
+
+	s := r.Remove("node-5")
+
+	for p := 0; p < 4096; p++ {
+		after, _ := r.Owner(UUID{byte(p >> 4), byte(p << 4)})
+
+		if after == "node-5" || (before[p] != "node-5" && after != before[p]) {
+			t.Fatal("only the partitions of the removed owner should move, partition:", p)
+		}
+	}
+
+	if s.Moved != countOwner(before, "node-5") || s.Owners != 7 {
+		t.Error("removing should move the owner's partitions, got:", s)
+	}
+
+	if s = r.Remove("unknown"); s.Moved != 0 {
+		t.Error("removing an unknown owner should move nothing, got:", s)
+	}
+}
+
+func countOwner(table map[int]string, owner string) int {
+
+	n := 0
+
+	for _, o := range table {
+		if o == owner {
+			n++
+		}
+	}
+
+	return n
+}
+
+func TestPrefixRouterOrder(t *testing.T) {
+
+	a, b := NewPrefixRouter(8), NewPrefixRouter(8)
+
+	for _, owner := range []string{"a", "b", "c", "d"} {
+		a.Add(owner)
+	}
+
+	for _, owner := range []string{"d", "x", "b", "a", "c"} {
+		b.Add(owner)
+	}
+
+	b.Remove("x")
+
+	for i := 0; i < testSize/100; i++ {
+		u := NewV4()
+		x, _ := a.Owner(u)
+		y, _ := b.Owner(u)
+
+		if x != y {
+			t.Fatal("the same owners should give the same table, got:", x, y)
+		}
+	}
+
+	if r := NewPrefixRouter(0); r.bits != 1 {
+		t.Error("prefix bits should be at least 1, got:", r.bits)
+	}
+
+	if r := NewPrefixRouter(64); r.bits != maxPrefixBits {
+		t.Error("prefix bits should be at most:", maxPrefixBits, "got:", r.bits)
+	}
+}
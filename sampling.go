@@ -0,0 +1,52 @@
+package uuid
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"math/bits"
+)
+
+// sampleHash is the first 8 bytes, big endian, of SHA-256(salt || the 16 UUID bytes)
+// It is part of the documented stable algorithm of SampleBit and must never change
+func sampleHash(u UUID, salt string) uint64 {
+
+	h := sha256.New()
+	h.Write([]byte(salt))
+	h.Write(u[:])
+
+	return binary.BigEndian.Uint64(h.Sum(nil))
+}
+
+// SampleBit reports whether u falls in the sampled rate (0 to 1) of IDs, for logging and tracing
+// decisions keyed by entity ID. The same u, rate and salt always give the same answer across
+// package versions; a different salt gives an independent sample.
+//
+// Algorithm: x is the first 8 bytes, big endian, of SHA-256(salt || u[0:16]).
+// The result is x < rate * 2^64, with rate <= 0 always false and rate >= 1 always true
+func SampleBit(u UUID, rate float64, salt string) bool {
+
+	if rate <= 0 {
+		return false
+	}
+
+	if rate >= 1 {
+		return true
+	}
+
+	return sampleHash(u, salt) < uint64(rate*(1<<64))
+}
+
+// VariantIndex deterministically assigns u to one of n experiment arms (0 to n-1), uniformly
+// and independently per salt. It returns 0 when n < 1.
+//
+// Algorithm: x as in SampleBit; the arm is the high 64 bits of x * n
+func VariantIndex(u UUID, salt string, n int) int {
+
+	if n < 1 {
+		return 0
+	}
+
+	hi, _ := bits.Mul64(sampleHash(u, salt), uint64(n))
+
+	return int(hi)
+}
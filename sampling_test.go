@@ -0,0 +1,61 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestSampleBit(t *testing.T) {
+
+	// pinned so the algorithm can not change between package versions
+	if sampleHash(DNSNamespace, "exp") != 0x1e827235fe99ff4b {
+		t.Error("SampleBit algorithm changed")
+	}
+
+	uuid := NewV4()
+
+	if SampleBit(uuid, 0, "s") || !SampleBit(uuid, 1, "s") {
+		t.Error("SampleBit ignores the 0 and 1 rates")
+	}
+
+	sampled := 0
+
+	for i := 0; i < 10000; i++ {
+		if SampleBit(NewV4(), 0.1, "salt") {
+			sampled++
+		}
+	}
+
+	if sampled < 800 || sampled > 1200 {
+		t.Error("SampleBit sampled", sampled, "of 10000 at 0.1")
+	}
+}
+
+func TestVariantIndex(t *testing.T) {
+
+	// x = 0x1e827235fe99ff4b is just under 0.12 of 2^64
+	if VariantIndex(DNSNamespace, "exp", 10) != 1 {
+		t.Error("VariantIndex algorithm changed")
+	}
+
+	arms := make([]int, 4)
+
+	for i := 0; i < 10000; i++ {
+		arm := VariantIndex(NewV4(), "exp", len(arms))
+
+		if arm < 0 || arm >= len(arms) {
+			t.Fatal("VariantIndex out of range", arm)
+		}
+
+		arms[arm]++
+	}
+
+	for _, n := range arms {
+		if n < 2200 || n > 2800 {
+			t.Error("VariantIndex is not uniform", arms)
+		}
+	}
+
+	if VariantIndex(DNSNamespace, "exp", 0) != 0 {
+		t.Error("VariantIndex with no arms should be 0")
+	}
+}
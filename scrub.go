@@ -0,0 +1,155 @@
+package uuid
+
+import (
+	"io"
+)
+
+const (
+	uuidStringSize = 36 // length of the 8-4-4-4-12 form
+	hex32          = 32 // length without the dashes
+)
+
+func isHex(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
+
+// isTokenByte reports whether c could continue a UUID shaped token
+func isTokenByte(c byte) bool {
+	return isHex(c) || c == '-'
+}
+
+// shapeAt reports whether an 8-4-4-4-12 token of either case starts at b[i]
+// and is not part of a longer run of hex and dashes. prev is the byte before b[0]
+func shapeAt(b []byte, i int, prev byte) bool {
+
+	if len(b)-i < uuidStringSize {
+		return false
+	}
+
+	// cheap rejects first
+	if b[i+8] != '-' || b[i+13] != '-' || b[i+18] != '-' || b[i+23] != '-' {
+		return false
+	}
+
+	if i > 0 {
+		prev = b[i-1]
+	}
+
+	if isTokenByte(prev) || (len(b) > i+uuidStringSize && isTokenByte(b[i+uuidStringSize])) {
+		return false
+	}
+
+	for j := 0; j < uuidStringSize; j++ {
+		switch j {
+		case 8, 13, 18, 23:
+		default:
+			if !isHex(b[i+j]) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// scrub appends b to dst with every valid UUID token replaced by replace(uuid).
+// Tokens that have the shape but fail FromString are copied unchanged. Unless atEOF,
+// the trailing bytes that could still start a token are left unprocessed and
+// the number of bytes processed is returned
+func scrub(dst, b []byte, prev byte, atEOF bool, replace func(UUID) string) ([]byte, int) {
+
+	var lower [uuidStringSize]byte
+
+	i := 0
+
+	for i < len(b) {
+		// a token starting here also needs the byte after it to be decided
+		if !atEOF && len(b)-i <= uuidStringSize {
+			break
+		}
+
+		if !shapeAt(b, i, prev) {
+			dst = append(dst, b[i])
+			i++
+			continue
+		}
+
+		for j, c := range b[i : i+uuidStringSize] {
+			if c >= 'A' && c <= 'F' {
+				c += 'a' - 'A'
+			}
+			lower[j] = c
+		}
+
+		uuid, err := parse(string(lower[:]))
+
+		if err != nil || replace == nil {
+			dst = append(dst, b[i:i+uuidStringSize]...)
+		} else {
+			dst = append(dst, replace(uuid)...)
+		}
+
+		i += uuidStringSize
+	}
+
+	return dst, i
+}
+
+// RedactUUIDs returns s with every valid UUID, in either case, replaced by replace(uuid).
+// It scans each position once without a regex, see ScrubWriter for streams
+func RedactUUIDs(s string, replace func(UUID) string) string {
+	out, _ := scrub(make([]byte, 0, len(s)), []byte(s), 0, true, replace)
+	return string(out)
+}
+
+// ScrubWriter is an io.Writer for log scrubbing pipelines. Every valid UUID written through it,
+// in either case, is replaced by the result of its replace func before reaching the
+// underlying writer. UUID shaped tokens that fail validation pass through unchanged
+type ScrubWriter struct {
+	w       io.Writer
+	replace func(UUID) string
+	buf     []byte // bytes that could still be the start of a token
+	prev    byte   // last byte passed through, for token boundaries
+}
+
+// NewScrubWriter returns a ScrubWriter writing to w. Call Flush when done writing
+func NewScrubWriter(w io.Writer, replace func(UUID) string) *ScrubWriter {
+	return &ScrubWriter{w: w, replace: replace}
+}
+
+// Write scrubs p. Up to 36 bytes are held back until the next Write or Flush
+// in case they are the start of a token split across writes
+func (s *ScrubWriter) Write(p []byte) (int, error) {
+
+	s.buf = append(s.buf, p...)
+
+	if err := s.drain(false); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Flush scrubs and writes anything held back. It does not close the underlying writer
+func (s *ScrubWriter) Flush() error {
+	return s.drain(true)
+}
+
+func (s *ScrubWriter) drain(atEOF bool) error {
+
+	out, n := scrub(nil, s.buf, s.prev, atEOF, s.replace)
+
+	if n > 0 {
+		s.prev = s.buf[n-1]
+	}
+
+	s.buf = append(s.buf[:0], s.buf[n:]...)
+
+	if len(out) == 0 {
+		return nil
+	}
+
+	_, err := s.w.Write(out)
+
+	return err
+}
@@ -0,0 +1,64 @@
+package uuid
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestScrubWriter(t *testing.T) {
+
+	in := "user 6BA7B810-9DAD-11D1-80B4-00C04FD430C8 did a thing to 6ba7b811-9dad-11d1-80b4-00c04fd430c8.\n" +
+		"bad version 6ba7b814-9dad-91d1-80b4-00c04fd430c8 part of a6ba7b810-9dad-11d1-80b4-00c04fd430c8\n"
+	want := "user [dns] did a thing to [url].\n" +
+		"bad version 6ba7b814-9dad-91d1-80b4-00c04fd430c8 part of a6ba7b810-9dad-11d1-80b4-00c04fd430c8\n"
+
+	replace := func(u UUID) string {
+		switch u {
+		case DNSNamespace:
+			return "[dns]"
+		case URLNamespace:
+			return "[url]"
+		}
+		return "[?]"
+	}
+
+	// every split point so tokens are cut across writes
+	for split := 0; split <= len(in); split++ {
+		var out bytes.Buffer
+		w := NewScrubWriter(&out, replace)
+
+		w.Write([]byte(in[:split]))
+		w.Write([]byte(in[split:]))
+
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+
+		if out.String() != want {
+			t.Fatal("ScrubWriter split at", split, "wrote", out.String())
+		}
+	}
+}
+
+func TestRedactUUIDs(t *testing.T) {
+
+	in := "6ba7b810-9dad-11d1-80b4-00c04fd430c8,6ba7b811-9dad-11d1-80b4-00c04fd430c8 ok"
+	out := RedactUUIDs(in, func(UUID) string { return "***" })
+
+	if out != "***,*** ok" {
+		t.Error("RedactUUIDs is", out)
+	}
+
+	if RedactUUIDs("no ids here", nil) != "no ids here" {
+		t.Error("RedactUUIDs changed text without UUIDs")
+	}
+}
+
+func BenchmarkRedactUUIDs(b *testing.B) {
+	line := "GET /users/6ba7b810-9dad-11d1-80b4-00c04fd430c8/orders 200 12ms trace=6ba7b811-9dad-11d1-80b4-00c04fd430c8"
+	replace := func(UUID) string { return "-" }
+
+	for n := 0; n < b.N; n++ {
+		devNull(RedactUUIDs(line, replace))
+	}
+}
@@ -0,0 +1,167 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+)
+
+const (
+	seqMagic      = "UUv7"
+	seqRecordSize = 20
+	seqSlotSize   = 512 // each record sits in its own disk sector
+
+	// seqAhead is how far (in milliseconds) the saved high-water mark runs ahead of the last v7,
+	// so the file is written and synced about once a second rather than for every UUID
+	seqAhead = 1000
+)
+
+// ErrSequenceFile is returned by OpenSequenceFile when the file holds data but neither record is valid
+var ErrSequenceFile = errors.New("sequence file has no valid record")
+
+// SequenceFile keeps the v7 high-water mark of a single writer on disk so v7 UUIDs keep increasing
+// across crashes and restarts, see WithSequenceFile. The file has two records, one per 512 byte sector:
+//
+//	bytes 0-3    "UUv7"
+//	bytes 4-7    write sequence number, big endian
+//	bytes 8-15   the mark: unix_ts_ms << 16 | 12 bit counter, the first 8 bytes of a v7 without the version
+//	bytes 16-19  CRC-32C (Castagnoli) of bytes 0-15, big endian
+//
+// Writes alternate between the records and are synced before the UUID is returned. A torn write
+// can only damage the record being written, it fails its CRC and the other record, one mark older,
+// is used instead. Every UUID handed out is below the saved mark, so the older mark is still safe
+type SequenceFile struct {
+	f    *os.File
+	seq  uint32
+	mark uint64
+}
+
+// OpenSequenceFile opens (or creates) the file at path and recovers the newest valid mark.
+// Only one process may use the file at a time
+func OpenSequenceFile(path string) (*SequenceFile, error) {
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+
+	if err != nil {
+		return nil, err
+	}
+
+	s := &SequenceFile{f: f}
+
+	if err = s.recover(); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// recover loads the newest record that passes its checks
+func (s *SequenceFile) recover() error {
+
+	var (
+		buf  [seqRecordSize]byte
+		seen bool // the file holds some data
+		ok   bool // a record was valid
+	)
+
+	for slot := int64(0); slot < 2; slot++ {
+		n, err := s.f.ReadAt(buf[:], slot*seqSlotSize)
+
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		if n == 0 {
+			continue
+		}
+
+		seen = true
+		seq, mark, valid := parseSeqRecord(buf[:n])
+
+		if valid && (!ok || seq > s.seq) {
+			s.seq, s.mark, ok = seq, mark, true
+		}
+	}
+
+	if seen && !ok {
+		return ErrSequenceFile
+	}
+
+	return nil
+}
+
+func parseSeqRecord(b []byte) (uint32, uint64, bool) {
+
+	if len(b) != seqRecordSize || string(b[:4]) != seqMagic {
+		return 0, 0, false
+	}
+
+	if binary.BigEndian.Uint32(b[16:]) != crc32.Checksum(b[:16], crcTable) {
+		return 0, 0, false
+	}
+
+	return binary.BigEndian.Uint32(b[4:]), binary.BigEndian.Uint64(b[8:]), true
+}
+
+// Mark returns the saved high-water mark as Unix milliseconds and counter
+func (s *SequenceFile) Mark() (int64, uint16) {
+	return int64(s.mark >> 16), uint16(s.mark & counter7Max)
+}
+
+// Advance saves a new mark and syncs it to disk
+func (s *SequenceFile) Advance(ms int64, counter uint16) error {
+
+	var b [seqRecordSize]byte
+
+	seq := s.seq + 1
+	mark := uint64(ms)<<16 | uint64(counter&counter7Max)
+
+	copy(b[:], seqMagic)
+	binary.BigEndian.PutUint32(b[4:], seq)
+	binary.BigEndian.PutUint64(b[8:], mark)
+	binary.BigEndian.PutUint32(b[16:], crc32.Checksum(b[:16], crcTable))
+
+	if _, err := s.f.WriteAt(b[:], int64(seq%2)*seqSlotSize); err != nil {
+		return err
+	}
+
+	if err := s.f.Sync(); err != nil {
+		return err
+	}
+
+	s.seq, s.mark = seq, mark
+
+	return nil
+}
+
+// Close closes the file
+func (s *SequenceFile) Close() error {
+	return s.f.Close()
+}
+
+// WithSequenceFile makes the Generator's v7 UUIDs continue above the mark saved in s, and keep the
+// mark ahead of every v7 it hands out. A failed write is returned by NewV7.
+// s must not be shared with another Generator
+func WithSequenceFile(s *SequenceFile) Option {
+	return func(g *Generator) {
+		g.seqFile = s
+		g.lastV7, g.counter7 = s.Mark()
+	}
+}
+
+// saveV7 moves the mark ahead once the last v7 reaches it
+func (g *Generator) saveV7() error {
+
+	if g.seqFile == nil {
+		return nil
+	}
+
+	if ms, _ := g.seqFile.Mark(); g.lastV7 < ms {
+		return nil
+	}
+
+	return g.seqFile.Advance(g.lastV7+seqAhead, 0)
+}
@@ -0,0 +1,93 @@
+package uuid
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestSequenceFile(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "v7.seq")
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	s, err := OpenSequenceFile(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ms, counter := s.Mark(); ms != 0 || counter != 0 {
+		t.Error("new file should have no mark, got:", ms, counter)
+	}
+
+	first, err := NewGenerator(WithClock(FixedClock(now)), WithSequenceFile(s)).NewV7()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ms, _ := s.Mark(); ms != now.UnixMilli()+seqAhead {
+		t.Error("mark should be:", now.UnixMilli()+seqAhead, "got:", ms)
+	}
+
+	s.Close()
+
+	// a restart with the clock an hour behind must still sort after the first run
+	if s, err = OpenSequenceFile(path); err != nil {
+		t.Fatal(err)
+	}
+
+	g := NewGenerator(WithClock(FixedClock(now.Add(-time.Hour))), WithSequenceFile(s))
+	last := first
+
+	for i := 0; i < testSize; i++ {
+		uuid, err := g.NewV7()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if bytes.Compare(uuid[:], last[:]) <= 0 {
+			t.Fatal("v7 after a restart should sort after:", last.String(), "got:", uuid.String())
+		}
+
+		last = uuid
+	}
+
+	s.Close()
+}
+
+func TestSequenceFileTornWrite(t *testing.T) {
+
+	path := filepath.Join(t.TempDir(), "v7.seq")
+	s, _ := OpenSequenceFile(path)
+
+	s.Advance(1000, 1)
+	s.Advance(2000, 2) // sequence 2 goes to the first record
+	s.Close()
+
+	f, _ := os.OpenFile(path, os.O_RDWR, 0)
+	f.WriteAt([]byte{0xFF, 0xFF}, 10) // tear the newest record
+	f.Close()
+
+	s, err := OpenSequenceFile(path)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ms, counter := s.Mark(); ms != 1000 || counter != 1 {
+		t.Error("torn record should fall back to: 1000 1 got:", ms, counter)
+	}
+
+	s.Close()
+
+	os.WriteFile(path, []byte("not a sequence file"), 0644)
+
+	if _, err = OpenSequenceFile(path); err != ErrSequenceFile {
+		t.Error("garbage should be:", ErrSequenceFile, "got:", err)
+	}
+}
@@ -0,0 +1,111 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"strings"
+)
+
+const (
+	// base58Alphabet is Bitcoin's, without 0, O, I and l
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+	base32Size = 26 // Crockford base32 characters for 16 bytes
+	base58Size = 22 // base58 characters for 128 bits, 58^22 > 2^128
+)
+
+// base58Index maps a character back to its digit, -1 for characters not in the alphabet
+var base58Index = func() (index [256]int8) {
+
+	for i := range index {
+		index[i] = -1
+	}
+
+	for i := 0; i < len(base58Alphabet); i++ {
+		index[base58Alphabet[i]] = int8(i)
+	}
+
+	return index
+}()
+
+// EncodeBase32 is the 26 character Crockford base32 form, for codes people read out or type:
+// it is case insensitive and has no I, L, O or U. See DecodeBase32 and, with a checksum, EncodeChecked
+func (u UUID) EncodeBase32() string {
+	return crockford.EncodeToString(u[:])
+}
+
+// DecodeBase32 reads the form written by EncodeBase32. Like DecodeChecked it ignores case and
+// hyphens and reads I and L as 1 and O as 0
+func DecodeBase32(s string) (UUID, error) {
+
+	s = crockfordNormalizer.Replace(strings.ToUpper(s))
+
+	if len(s) != base32Size {
+		return UUID{}, ErrUUIDFormat
+	}
+
+	b, err := crockford.DecodeString(s)
+
+	if err != nil {
+		return UUID{}, ErrUUIDFormat
+	}
+
+	return FromBytes(b)
+}
+
+// EncodeBase58 is the 22 character base58 form, for short URLs: it has only letters and digits
+// and leaves out 0, O, I and l. It is padded with leading 1s (the zero digit) to a fixed length,
+// so the strings sort in the same order as the UUIDs
+func (u UUID) EncodeBase58() string {
+
+	var dst [base58Size]byte
+
+	hi, lo := binary.BigEndian.Uint64(u[0:]), binary.BigEndian.Uint64(u[8:])
+
+	for i := base58Size - 1; i >= 0; i-- {
+		var r uint64
+
+		hi, r = bits.Div64(0, hi, 58)
+		lo, r = bits.Div64(r, lo, 58)
+		dst[i] = base58Alphabet[r]
+	}
+
+	return string(dst[:])
+}
+
+// DecodeBase58 reads the form written by EncodeBase58. It is case sensitive
+func DecodeBase58(s string) (UUID, error) {
+
+	if len(s) != base58Size {
+		return UUID{}, ErrUUIDFormat
+	}
+
+	var hi, lo uint64
+
+	for i := 0; i < len(s); i++ {
+		d := base58Index[s[i]]
+
+		if d < 0 {
+			return UUID{}, ErrUUIDFormat
+		}
+
+		// (hi, lo) = (hi, lo) * 58 + d, failing past 128 bits
+		carry, low := bits.Mul64(lo, 58)
+		low, c := bits.Add64(low, uint64(d), 0)
+		over, high := bits.Mul64(hi, 58)
+		high, c = bits.Add64(high, carry, c)
+
+		if over != 0 || c != 0 {
+			return UUID{}, ErrUUIDFormat
+		}
+
+		hi, lo = high, low
+	}
+
+	var b [uuidSize]byte
+
+	binary.BigEndian.PutUint64(b[0:], hi)
+	binary.BigEndian.PutUint64(b[8:], lo)
+
+	return FromBytes(b[:])
+}
@@ -0,0 +1,89 @@
+package uuid
+
+import (
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestBase32(t *testing.T) {
+
+	if s := NamespaceDNS.EncodeBase32(); s != "DEKVG44XNM8X305M0304ZN1GS0" {
+		t.Error("EncodeBase32 should be:", "DEKVG44XNM8X305M0304ZN1GS0", "got:", s)
+	}
+
+	// lowercase, hyphens and look alikes are read as typed
+	if uuid, err := DecodeBase32("dekvg44x-nm8x3o5m-o3o4zn1g-so"); err != nil || uuid != NamespaceDNS {
+		t.Error("DecodeBase32 should be:", NamespaceDNS, "got:", uuid, err)
+	}
+
+	for i := 0; i < testSize/100; i++ {
+		uuid := NewV4()
+
+		if back, err := DecodeBase32(uuid.EncodeBase32()); err != nil || back != uuid {
+			t.Fatal("base32 did not round trip", uuid, err)
+		}
+	}
+
+	for _, s := range []string{"", "DEKVG44XNM8X305M0304ZN1GS", "DEKVG44XNM8X305M0304ZN1GSU"} {
+		if _, err := DecodeBase32(s); err != ErrUUIDFormat {
+			t.Error("DecodeBase32 should reject", s, "got:", err)
+		}
+	}
+}
+
+func TestBase58(t *testing.T) {
+
+	tests := map[UUID]string{
+		NamespaceDNS: "EJ34kCVxxF9jHMKD4EgrAK",
+		Nil:          "1111111111111111111111",
+		Max:          "YcVfxkQb6JRzqk5kF2tNLv",
+	}
+
+	for uuid, want := range tests {
+		s := uuid.EncodeBase58()
+
+		if s != want {
+			t.Error("EncodeBase58 should be:", want, "got:", s)
+		}
+
+		if back, err := DecodeBase58(s); err != nil || back != uuid {
+			t.Error("DecodeBase58 should be:", uuid, "got:", back, err)
+		}
+	}
+
+	encoded := make([]string, testSize/100)
+
+	for i := range encoded {
+		uuid := NewV4()
+		encoded[i] = uuid.EncodeBase58()
+
+		if back, err := DecodeBase58(encoded[i]); err != nil || back != uuid {
+			t.Fatal("base58 did not round trip", uuid, err)
+		}
+	}
+
+	// fixed width keeps the order of the UUIDs
+	uuids := make([]string, len(encoded))
+
+	for i, s := range encoded {
+		uuid, _ := DecodeBase58(s)
+		uuids[i] = uuid.String()
+	}
+
+	sort.Strings(encoded)
+	sort.Strings(uuids)
+
+	for i, s := range encoded {
+		if uuid, _ := DecodeBase58(s); uuid.String() != uuids[i] {
+			t.Fatal("base58 should sort like the UUIDs")
+		}
+	}
+
+	// too short, 0 is not in the alphabet, and past 128 bits
+	for _, s := range []string{"EJ34kCVxxF9jHMKD4EgrA", "EJ34kCVxxF9jHMKD4Egr0K", "YcVfxkQb6JRzqk5kF2tNLw", strings.Repeat("z", 22)} {
+		if _, err := DecodeBase58(s); err != ErrUUIDFormat {
+			t.Error("DecodeBase58 should reject", s, "got:", err)
+		}
+	}
+}
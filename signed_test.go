@@ -0,0 +1,37 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSignUUID(t *testing.T) {
+
+	key := []byte("webhook secret")
+	uuid := NewV4()
+	token := SignUUID(uuid, key, time.Now().Add(time.Hour))
+
+	if len(token) != 54 {
+		t.Error("signed token is", len(token), "characters")
+	}
+
+	back, err := VerifyUUID(token, key)
+
+	if err != nil || back != uuid {
+		t.Error("signed token did not verify", err)
+	}
+
+	if _, err = VerifyUUID(token, []byte("wrong")); err != ErrTokenInvalid {
+		t.Error("signed token verified with the wrong key", err)
+	}
+
+	expired := SignUUID(uuid, key, time.Now().Add(-time.Second))
+
+	if _, err = VerifyUUID(expired, key); err != ErrTokenExpired {
+		t.Error("expired token verified", err)
+	}
+
+	if _, err = VerifyUUID(token[:40], key); err != ErrTokenInvalid {
+		t.Error("short token verified", err)
+	}
+}
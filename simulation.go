@@ -0,0 +1,51 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"math/rand/v2"
+	"sync"
+)
+
+// EnableSimulation makes the package deterministic for simulation testing (in the style of
+// FoundationDB): every random bit comes from a ChaCha8 stream seeded with seed, the time comes
+// from clock, and the default Generator gets a node ID and clock sequence from that stream instead
+// of looking at network interfaces. Running the same seed with the same clock readings gives the
+// same UUIDs from the package level constructors and from Generators made afterwards.
+// Generators made before, and any with their own WithRandSource or WithClock, keep their sources.
+// Call it before the code under test starts; Configure(Config{}) goes back to crypto/rand and
+// the system clock, but not to the discovered node ID
+func EnableSimulation(seed int64, clock Clock) {
+
+	var key [32]byte
+	binary.BigEndian.PutUint64(key[:], uint64(seed))
+
+	r := &lockedReader{r: rand.NewChaCha8(key)}
+
+	updateConfig(func(c *Config) {
+		c.Rand = r
+		c.Clock = clock
+	})
+
+	g := defaultGenerator
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.addr, g.nodeSource = randomNode(), NodeRandom
+	g.clockSeq = g.randomClockSeq()
+	g.lastTime, g.lastClock = 0, 0
+	g.lastV7, g.counter7 = 0, 0
+	g.turn = 0
+}
+
+// lockedReader makes a ChaCha8 safe for concurrent use, as SetRandSource requires
+type lockedReader struct {
+	mu sync.Mutex
+	r  *rand.ChaCha8
+}
+
+func (l *lockedReader) Read(b []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Read(b)
+}
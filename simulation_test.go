@@ -0,0 +1,40 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEnableSimulation(t *testing.T) {
+
+	defer Configure(Config{})
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	run := func() []UUID {
+		EnableSimulation(42, FixedClock(now))
+		return []UUID{NewV1(), NewV4(), NewV6(), NewV7(), NewV4()}
+	}
+
+	first, second := run(), run()
+
+	for i := range first {
+		if first[i] != second[i] {
+			t.Error("simulated UUID", i, "should be:", first[i].String(), "got:", second[i].String())
+		}
+	}
+
+	if created, _ := first[3].Time(); !created.Equal(now) {
+		t.Error("simulated v7 should be made at:", now, "got:", created)
+	}
+
+	if DefaultNodeSource() != NodeRandom {
+		t.Error("simulation should use a random node, got:", DefaultNodeSource())
+	}
+
+	EnableSimulation(43, FixedClock(now))
+
+	if NewV4() == first[1] {
+		t.Error("another seed should give other UUIDs")
+	}
+}
@@ -0,0 +1,37 @@
+package uuid
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"os"
+)
+
+// WithSiteLocalNode replaces the hardware address in v1 and v2 UUIDs with an HMAC-SHA256 of the
+// hostname keyed by salt. The node is stable per host, so ops can still tell hosts apart, but the
+// MAC address cannot be recovered from it. The multicast bit is set as RFC4122 Section 4.5 requires
+// for node IDs that are not a real address. If the hostname cannot be read the node is random
+func WithSiteLocalNode(salt []byte) Option {
+	return func(g *Generator) {
+		host, err := os.Hostname()
+
+		if err != nil {
+			g.addr, g.nodeSource = randomNode(), NodeRandom
+			return
+		}
+
+		g.addr, g.nodeSource = siteLocalNode(host, salt), NodeSiteLocal
+	}
+}
+
+func siteLocalNode(host string, salt []byte) [6]byte {
+
+	var node [6]byte
+
+	h := hmac.New(sha256.New, salt)
+	h.Write([]byte(host))
+	copy(node[:], h.Sum(nil))
+
+	node[0] |= 0x01 // multicast bit
+
+	return node
+}
@@ -0,0 +1,33 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestSiteLocalNode(t *testing.T) {
+
+	node := siteLocalNode("host-a", []byte("salt"))
+
+	if node[0]&0x01 == 0 {
+		t.Error("site local node does not have the multicast bit set")
+	}
+
+	if siteLocalNode("host-a", []byte("salt")) != node {
+		t.Error("site local node is not stable")
+	}
+
+	if siteLocalNode("host-b", []byte("salt")) == node || siteLocalNode("host-a", []byte("pepper")) == node {
+		t.Error("site local node does not depend on host and salt")
+	}
+
+	g := NewGenerator(WithSiteLocalNode([]byte("salt")))
+	uuid, err := g.NewV1()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if uuid[10]&0x01 == 0 {
+		t.Error("v1 from a site local Generator does not carry the site local node", uuid.String())
+	}
+}
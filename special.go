@@ -0,0 +1,21 @@
+package uuid
+
+var (
+	// Nil is the nil UUID with all 128 bits set to zero https://tools.ietf.org/html/rfc4122#section-4.1.7
+	// It has no version or variant but parses, so it round trips as a NULL sentinel
+	Nil UUID
+
+	// Max is the max UUID with all 128 bits set to one https://www.rfc-editor.org/rfc/rfc9562#section-5.10
+	// Like Nil it parses without a version or variant, it is used as the upper bound of ranges
+	Max = UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+)
+
+// IsNil reports whether u is Nil
+func (u UUID) IsNil() bool {
+	return u == Nil
+}
+
+// IsMax reports whether u is Max
+func (u UUID) IsMax() bool {
+	return u == Max
+}
@@ -0,0 +1,45 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestNil(t *testing.T) {
+
+	if !Nil.IsNil() || NewV4().IsNil() {
+		t.Error("IsNil should only be true for Nil")
+	}
+
+	uuid, err := FromString("00000000-0000-0000-0000-000000000000")
+
+	if err != nil || !uuid.IsNil() {
+		t.Error("FromString should accept Nil", err)
+	}
+
+	if uuid, err = FromBytes(Nil[:]); err != nil || uuid != Nil {
+		t.Error("FromBytes should accept Nil", err)
+	}
+
+	if r := Explain("00000000-0000-0000-0000-000000000000"); !r.OK() {
+		t.Error("Explain should accept Nil", r.String())
+	}
+}
+
+func TestMax(t *testing.T) {
+
+	if !Max.IsMax() || Nil.IsMax() || Max.String() != "ffffffff-ffff-ffff-ffff-ffffffffffff" {
+		t.Error("Max should be all ones, got:", Max.String())
+	}
+
+	for _, s := range []string{"ffffffff-ffff-ffff-ffff-ffffffffffff", "FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF"} {
+		uuid, err := FromString(s)
+
+		if err != nil || uuid != Max {
+			t.Error("FromString should accept Max", s, err)
+		}
+
+		if r := Explain(s); !r.OK() {
+			t.Error("Explain should accept Max", r.String())
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"errors"
+)
+
+// ErrScanType is returned by Scan for a column value that is not []byte, string or nil
+var ErrScanType = errors.New("UUID can only be scanned from []byte, string or nil")
+
+// Scan implements sql.Scanner so a UUID can be the destination of a uuid (Postgres),
+// BINARY(16) or CHAR(36) (MySQL) or TEXT (SQLite) column.
+// A 16 byte []byte is the raw UUID; any other []byte, and a string, is text in a form UnmarshalText accepts.
+// A NULL leaves Nil
+func (u *UUID) Scan(src interface{}) error {
+
+	switch src := src.(type) {
+	case nil:
+		*u = Nil
+		return nil
+	case []byte:
+		if len(src) == uuidSize {
+			return u.UnmarshalBinary(src)
+		}
+
+		return u.UnmarshalText(src)
+	case string:
+		return u.UnmarshalText([]byte(src))
+	}
+
+	return ErrScanType
+}
+
+// Value implements driver.Valuer with the lowercase 8-4-4-4-12 form, which Postgres uuid,
+// MySQL CHAR(36) and SQLite TEXT columns all take. After PreferBinaryColumns it is the raw
+// 16 bytes for BINARY(16) and BLOB columns instead
+func (u UUID) Value() (driver.Value, error) {
+
+	if err := checkOutput(u); err != nil {
+		return nil, err
+	}
+
+	if currentConfig().BinaryColumns {
+		return append([]byte(nil), u[:]...), nil
+	}
+
+	return u.canonical(), nil
+}
+
+// PreferBinaryColumns makes Value write the raw 16 bytes, leaving the rest of the Config as it is.
+// Scan reads both forms whatever the mode, so a service can switch while a table holds a mix
+// of CHAR(36) and BINARY(16) values; see MigrateToBinary for moving the old rows over
+func PreferBinaryColumns() {
+	updateConfig(func(c *Config) {
+		c.BinaryColumns = true
+	})
+}
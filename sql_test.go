@@ -0,0 +1,85 @@
+package uuid
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*UUID)(nil)
+	_ driver.Valuer = UUID{}
+)
+
+func TestScan(t *testing.T) {
+
+	tests := []interface{}{
+		DNSNamespace[:],
+		[]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+	}
+
+	for _, test := range tests {
+		var uuid UUID
+
+		if err := uuid.Scan(test); err != nil || uuid != DNSNamespace {
+			t.Error("Scan failed on", test, err)
+		}
+	}
+
+	uuid := DNSNamespace
+
+	if err := uuid.Scan(nil); err != nil || uuid != Nil {
+		t.Error("Scan of NULL should be Nil, got:", uuid.String(), err)
+	}
+
+	bad := []interface{}{42, []byte("6ba7b810"), "not a uuid", bytes.Repeat([]byte{0x91}, 16)}
+
+	for _, test := range bad {
+		if err := uuid.Scan(test); err == nil {
+			t.Error("Scan accepted", test)
+		}
+	}
+
+	if err := uuid.Scan(42); err != ErrScanType {
+		t.Error("Scan of an int should be:", ErrScanType, "got:", err)
+	}
+}
+
+func TestValue(t *testing.T) {
+
+	v, err := DNSNamespace.Value()
+
+	if err != nil || v != "6ba7b810-9dad-11d1-80b4-00c04fd430c8" {
+		t.Error("Value should be: 6ba7b810-9dad-11d1-80b4-00c04fd430c8 got:", v, err)
+	}
+}
+
+func TestPreferBinaryColumns(t *testing.T) {
+
+	defer Configure(Config{})
+
+	if err := Configure(Config{OutputCase: UpperCase}); err != nil {
+		t.Fatal(err)
+	}
+
+	PreferBinaryColumns()
+
+	v, err := DNSNamespace.Value()
+
+	if b, ok := v.([]byte); err != nil || !ok || !bytes.Equal(b, DNSNamespace[:]) {
+		t.Error("Value should be the raw bytes, got:", v, err)
+	}
+
+	if currentConfig().OutputCase != UpperCase {
+		t.Error("PreferBinaryColumns should keep the rest of the Config")
+	}
+
+	var uuid UUID
+
+	if err := uuid.Scan("6ba7b810-9dad-11d1-80b4-00c04fd430c8"); err != nil || uuid != DNSNamespace {
+		t.Error("Scan should still read text", err)
+	}
+}
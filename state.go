@@ -0,0 +1,171 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"path/filepath"
+)
+
+const (
+	stateSize = 16
+
+	// stateAhead is how far ahead of the clock the saved timestamp is (10 seconds in 100ns ticks).
+	// The state is only written again once the clock passes it, see Generator.saveState
+	stateAhead = 10 * 10000000
+)
+
+// ErrStateFormat is returned when a saved State is not 16 bytes
+var ErrStateFormat = errors.New("UUID generator state should be 16 bytes")
+
+// State is what https://tools.ietf.org/html/rfc4122#section-4.2.1 asks a v1 generator to keep
+// in stable storage: the last timestamp, the clock sequence and the node ID
+type State struct {
+	Timestamp uint64 // 60 bit timestamp of v1 and v6
+	ClockSeq  uint16
+	Node      [6]byte
+}
+
+// StateStore keeps a Generator's State across restarts, see WithStateStore.
+// Load returns ok false when nothing has been saved yet
+type StateStore interface {
+	Load() (s State, ok bool, err error)
+	Save(s State) error
+}
+
+// WithStateStore makes v1 and v6 UUIDs stay unique across restarts on the same machine.
+// NewGenerator loads the saved state: with the same node ID the clock sequence carries on, and a
+// clock now behind the saved timestamp changes the clock sequence like any other regression.
+// A different node ID (or nothing saved, or a state that cannot be loaded) keeps the random clock sequence.
+// The saved timestamp runs a few seconds ahead of the clock so the store is written about every
+// 10 seconds rather than for every UUID. A failed Save is returned by the New call.
+// It does not apply to a Generator using WithNodeRotation
+func WithStateStore(s StateStore) Option {
+	return func(g *Generator) {
+		g.store = s
+	}
+}
+
+// loadState is called by NewGenerator once the clock sequence is set. The saved state belongs
+// to a node ID, so a Generator with a store resolves its node up front
+func (g *Generator) loadState() {
+
+	if g.store == nil || len(g.rotation) > 0 {
+		return
+	}
+
+	s, ok, err := g.store.Load()
+
+	if err != nil || !ok || s.Node != g.node() {
+		return
+	}
+
+	g.clockSeq = s.ClockSeq
+	g.lastTime, g.lastClock = s.Timestamp, s.Timestamp
+	g.savedTime, g.savedSeq = s.Timestamp, s.ClockSeq
+}
+
+// saveState writes the state once the last timestamp passes the one saved,
+// or straight away when the clock sequence changed
+func (g *Generator) saveState() error {
+
+	if g.store == nil || len(g.rotation) > 0 || (g.lastTime < g.savedTime && g.clockSeq == g.savedSeq) {
+		return nil
+	}
+
+	s := State{Timestamp: g.lastTime + stateAhead, ClockSeq: g.clockSeq, Node: g.addr}
+
+	if err := g.store.Save(s); err != nil {
+		return err
+	}
+
+	g.savedTime, g.savedSeq = s.Timestamp, s.ClockSeq
+
+	return nil
+}
+
+// MarshalBinary is the 16 byte form FileStateStore writes:
+// the timestamp (8 bytes), clock sequence (2) and node (6), big endian
+func (s State) MarshalBinary() ([]byte, error) {
+
+	b := make([]byte, stateSize)
+
+	binary.BigEndian.PutUint64(b[0:], s.Timestamp)
+	binary.BigEndian.PutUint16(b[8:], s.ClockSeq)
+	copy(b[10:], s.Node[:])
+
+	return b, nil
+}
+
+// UnmarshalBinary reads the form written by MarshalBinary
+func (s *State) UnmarshalBinary(b []byte) error {
+
+	if len(b) != stateSize {
+		return ErrStateFormat
+	}
+
+	s.Timestamp = binary.BigEndian.Uint64(b[0:])
+	s.ClockSeq = binary.BigEndian.Uint16(b[8:])
+	copy(s.Node[:], b[10:])
+
+	return nil
+}
+
+// FileStateStore keeps the State in the file at path. Saves go to a temporary file
+// that is synced and renamed over path, so a crash leaves either the old or the new state
+type FileStateStore string
+
+// Load reads the file, ok is false if it does not exist
+func (f FileStateStore) Load() (State, bool, error) {
+
+	var s State
+
+	b, err := os.ReadFile(string(f))
+
+	if os.IsNotExist(err) {
+		return s, false, nil
+	}
+
+	if err != nil {
+		return s, false, err
+	}
+
+	if err = s.UnmarshalBinary(b); err != nil {
+		return s, false, err
+	}
+
+	return s, true, nil
+}
+
+// Save replaces the file
+func (f FileStateStore) Save(s State) error {
+	b, _ := s.MarshalBinary()
+	return replaceFile(string(f), b)
+}
+
+// replaceFile writes b to a synced temporary file and renames it over path,
+// so a crash leaves either the old or the new contents
+func replaceFile(path string, b []byte) error {
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name()) // fails harmlessly once renamed
+
+	if _, err = tmp.Write(b); err == nil {
+		err = tmp.Sync()
+	}
+
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,78 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type failStore struct{}
+
+func (failStore) Load() (State, bool, error) { return State{}, false, nil }
+func (failStore) Save(State) error           { return errors.New("disk full") }
+
+func TestFileStateStore(t *testing.T) {
+
+	store := FileStateStore(filepath.Join(t.TempDir(), "uuid.state"))
+
+	if _, ok, err := store.Load(); ok || err != nil {
+		t.Fatal("empty store should load nothing", ok, err)
+	}
+
+	want := State{Timestamp: 1 << 59, ClockSeq: 0x1234, Node: [6]byte{1, 2, 3, 4, 5, 6}}
+
+	if err := store.Save(want); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, ok, err := store.Load(); !ok || err != nil || got != want {
+		t.Error("state should be:", want, "got:", got, ok, err)
+	}
+
+	var s State
+
+	if err := s.UnmarshalBinary(make([]byte, 15)); err != ErrStateFormat {
+		t.Error("15 bytes should be:", ErrStateFormat, "got:", err)
+	}
+}
+
+func TestWithStateStore(t *testing.T) {
+
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	node := [6]byte{1, 2, 3, 4, 5, 6}
+	store := FileStateStore(filepath.Join(t.TempDir(), "uuid.state"))
+	opts := []Option{WithClock(FixedClock(now)), WithNodeID(node), WithStateStore(store)}
+
+	first, err := NewGenerator(opts...).NewV1()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saved, ok, err := store.Load()
+
+	if !ok || err != nil || saved.Node != node || saved.ClockSeq&0x3FFF != binary.BigEndian.Uint16(first[8:])&0x3FFF {
+		t.Fatal("state was not saved", saved, ok, err)
+	}
+
+	// a restart with the clock behind the saved timestamp must not reuse the clock sequence
+	again, err := NewGenerator(opts...).NewV1()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if again == first || binary.BigEndian.Uint16(again[8:])&0x3FFF != (saved.ClockSeq+1)&0x3FFF {
+		t.Error("restart should bump the clock sequence", first.String(), again.String())
+	}
+
+	if resaved, _, _ := store.Load(); resaved.ClockSeq != saved.ClockSeq+1 {
+		t.Error("a new clock sequence should be saved straight away", resaved)
+	}
+
+	if _, err = NewGenerator(WithStateStore(failStore{})).NewV1(); err == nil {
+		t.Error("a failed Save should be returned")
+	}
+}
@@ -0,0 +1,33 @@
+package uuid
+
+import (
+	"strings"
+)
+
+// ParseStrict only accepts the lowercase 8-4-4-4-12 form of an RFC4122 variant UUID with a version
+// from 1 to 8, or Nil or Max, for inputs that must already be canonical (keys, signatures, URLs).
+// Everything else returns ErrUUIDFormat, whatever the Config says
+func ParseStrict(s string) (UUID, error) {
+
+	if !IsCanonical(s) {
+		return UUID{}, ErrUUIDFormat
+	}
+
+	uuid, err := decodeString(s)
+
+	if err != nil {
+		return uuid, err
+	}
+
+	if uuid != Nil && uuid != Max && (uuid.Variant() != VariantRFC4122 || !knownVersion(uuid)) {
+		return UUID{}, ErrUUIDFormat
+	}
+
+	return uuid, nil
+}
+
+// ParseLenient accepts any 128 bit value in a form FromStringLenient reads, with any version and
+// variant, for reading IDs from systems that do not follow RFC4122. It ignores Config.StrictParse
+func ParseLenient(s string) (UUID, error) {
+	return decodeString(strings.ToLower(strings.Map(mapLookalike, strings.TrimSpace(s))))
+}
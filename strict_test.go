@@ -0,0 +1,56 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestParseStrict(t *testing.T) {
+
+	good := []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"00000000-0000-0000-0000-000000000000",
+		"ffffffff-ffff-ffff-ffff-ffffffffffff",
+	}
+
+	for _, s := range good {
+		if _, err := ParseStrict(s); err != nil {
+			t.Error("ParseStrict should accept", s, err)
+		}
+	}
+
+	bad := []string{
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"6ba7b810-9dad-91d1-80b4-00c04fd430c8", // version 9
+		"6ba7b810-9dad-11d1-30b4-00c04fd430c8", // NCS variant
+	}
+
+	for _, s := range bad {
+		if _, err := ParseStrict(s); err != ErrUUIDFormat {
+			t.Error("ParseStrict of", s, "should be:", ErrUUIDFormat, "got:", err)
+		}
+	}
+}
+
+func TestParseLenient(t *testing.T) {
+
+	tests := []string{
+		" 6ba7b810-9dad-91d1-80b4-00c04fd430c8\n",
+		"6BA7B810-9DAD-91D1-80B4-00C04FD430C8",
+		"{6ba7b810-9dad-91d1-80b4-00c04fd430c8}",
+		"６ba7b810-9dad-91d1-80b4-00c04fd430c8",
+	}
+
+	for _, s := range tests {
+		uuid, err := ParseLenient(s)
+
+		if err != nil || uuid.String() != "6ba7b810-9dad-91d1-80b4-00c04fd430c8" {
+			t.Error("ParseLenient should accept", s, uuid.String(), err)
+		}
+	}
+
+	if _, err := ParseLenient("6ba7b810-9dad"); err != ErrUUIDFormat {
+		t.Error("ParseLenient of a short string should be:", ErrUUIDFormat, "got:", err)
+	}
+}
@@ -0,0 +1,43 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSubTickSequence(t *testing.T) {
+
+	// 370ns past the microsecond, which the option rounds down
+	now := time.Date(2024, 5, 1, 12, 0, 0, 370, time.UTC)
+	g := NewGenerator(WithClock(FixedClock(now)), WithSubTickSequence())
+
+	for i := 0; i < 2*subTicks; i++ {
+		uuid, err := g.NewV1()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if seq, ok := SubTickSequence(uuid); !ok || seq != uint16(i%subTicks) {
+			t.Error("sequence should be:", i%subTicks, "got:", seq, ok)
+		}
+
+		if ts, _ := uuid.Time(); !ts.Equal(now.Truncate(time.Microsecond).Add(time.Duration(i) * 100)) {
+			t.Error("time should carry over into the next microsecond, got:", ts)
+		}
+	}
+
+	uuid, _ := g.NewV6()
+
+	if seq, ok := SubTickSequence(uuid); !ok || seq != 0 {
+		t.Error("v6 sequence should be:", 0, "got:", seq, ok)
+	}
+
+	if _, ok := SubTickSequence(NewV2(DomainPerson, 0)); ok {
+		t.Error("v2 should have no sub tick sequence")
+	}
+
+	if _, ok := SubTickSequence(NewV4()); ok {
+		t.Error("v4 should have no sub tick sequence")
+	}
+}
@@ -0,0 +1,50 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+)
+
+var (
+	// ErrSystemUUID is returned when the platform does not expose a machine UUID or it is unreadable
+	ErrSystemUUID = errors.New("system UUID is not available")
+)
+
+// SystemUUID returns the SMBIOS/DMI system UUID of the machine.
+// It comes from /sys/class/dmi/id/product_uuid on Linux, IOPlatformUUID on macOS and
+// Win32_ComputerSystemProduct on Windows. Reading it on Linux usually requires root.
+// Firmware vendors do not always follow RFC4122 so the version and variant are not checked
+func SystemUUID() (UUID, error) {
+
+	s, err := readSystemUUID()
+
+	if err != nil {
+		return UUID{}, err
+	}
+
+	return parseSystemUUID(s)
+}
+
+// parseSystemUUID decodes the 32 hex digits of a firmware UUID without checking version or variant
+func parseSystemUUID(s string) (UUID, error) {
+
+	var uuid UUID
+
+	s = strings.Replace(strings.TrimSpace(s), "-", "", -1)
+
+	if len(s) != hex.EncodedLen(uuidSize) {
+		return uuid, ErrSystemUUID
+	}
+
+	if _, err := hex.Decode(uuid[:], []byte(s)); err != nil {
+		return uuid, err
+	}
+
+	// unset or blank firmware fields
+	if uuid == (UUID{}) || uuid == (UUID{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}) {
+		return uuid, ErrSystemUUID
+	}
+
+	return uuid, nil
+}
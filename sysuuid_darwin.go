@@ -0,0 +1,26 @@
+package uuid
+
+import (
+	"os/exec"
+	"regexp"
+)
+
+var ioPlatformUUID = regexp.MustCompile(`"IOPlatformUUID" = "([0-9A-Fa-f-]{36})"`)
+
+// IOKit needs cgo, so ask ioreg for the IOPlatformExpertDevice instead
+func readSystemUUID() (string, error) {
+
+	out, err := exec.Command("ioreg", "-rd1", "-c", "IOPlatformExpertDevice").Output()
+
+	if err != nil {
+		return "", err
+	}
+
+	m := ioPlatformUUID.FindSubmatch(out)
+
+	if m == nil {
+		return "", ErrSystemUUID
+	}
+
+	return string(m[1]), nil
+}
@@ -0,0 +1,16 @@
+package uuid
+
+import (
+	"os"
+)
+
+func readSystemUUID() (string, error) {
+
+	b, err := os.ReadFile("/sys/class/dmi/id/product_uuid")
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,7 @@
+//go:build !linux && !darwin && !windows
+
+package uuid
+
+func readSystemUUID() (string, error) {
+	return "", ErrSystemUUID
+}
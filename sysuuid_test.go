@@ -0,0 +1,31 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestParseSystemUUID(t *testing.T) {
+
+	uuid, err := parseSystemUUID("4C4C4544-0042-3510-8052-B4C04F4E4D32\n")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if uuid.String() != "4c4c4544-0042-3510-8052-b4c04f4e4d32" {
+		t.Error("parseSystemUUID is", uuid.String())
+	}
+
+	bad := []string{
+		"",
+		"00000000-0000-0000-0000-000000000000",
+		"FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF",
+		"Not Settable",
+	}
+
+	for _, s := range bad {
+		if _, err := parseSystemUUID(s); err == nil {
+			t.Error("parseSystemUUID did not reject", s)
+		}
+	}
+}
@@ -0,0 +1,17 @@
+package uuid
+
+import (
+	"os/exec"
+)
+
+// WMI is only reachable through COM, so query it through PowerShell
+func readSystemUUID() (string, error) {
+
+	out, err := exec.Command("powershell", "-NoProfile", "-Command", "(Get-CimInstance -ClassName Win32_ComputerSystemProduct).UUID").Output()
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(out), nil
+}
@@ -0,0 +1,68 @@
+package uuid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTenantGenerator(t *testing.T) {
+
+	a, b := NewTenantGenerator(NewV4()), NewTenantGenerator(NewV4())
+
+	if x, y := a.Name("invoice-1"), a.Name("invoice-1"); x != y {
+		t.Error("Name should be deterministic, got:", x, y)
+	}
+
+	if a.Name("invoice-1") == b.Name("invoice-1") {
+		t.Error("tenants should get different UUIDs for the same name")
+	}
+
+	if NewTenantGenerator(a.Tenant()).Name("invoice-1") != a.Name("invoice-1") {
+		t.Error("the same tenant should get the same space")
+	}
+
+	for i := 0; i < testSize/100; i++ {
+		for _, uuid := range []UUID{a.New(), a.Name(string(rune(i)))} {
+			if uuid.Version() != 8 || uuid.Variant() != VariantRFC4122 {
+				t.Fatal("tenant UUIDs should be v8, got:", uuid)
+			}
+
+			if !a.Owns(uuid) || b.Owns(uuid) {
+				t.Fatal("only the tenant should own its UUIDs:", uuid)
+			}
+		}
+	}
+
+	if a.Owns(NewV4()) {
+		t.Error("a v4 should not belong to a tenant")
+	}
+
+	if a.Namespace() == b.Namespace() || a.Namespace().Version() != 5 {
+		t.Error("tenants should get their own v5 namespace, got:", a.Namespace(), b.Namespace())
+	}
+}
+
+func TestVerifyTenantIsolation(t *testing.T) {
+
+	tenants := make([]UUID, 1000)
+
+	for i := range tenants {
+		tenants[i] = NewV4()
+	}
+
+	if err := VerifyTenantIsolation(append(tenants, tenants[0])...); err != nil {
+		t.Error("random tenants should not share a tag:", err)
+	}
+
+	// a tag of one byte makes tenants share them
+	weak := func(u UUID) [tenantTagSize]byte { return [tenantTagSize]byte{u[0] & 0x01} }
+	err := verifyTags([]UUID{{0x00}, {0x01}, {0x02}, {0x00}}, weak)
+
+	if !errors.Is(err, ErrTenantTag) {
+		t.Error("shared tags should be:", ErrTenantTag, "got:", err)
+	}
+
+	if n := len(err.(interface{ Unwrap() []error }).Unwrap()); n != 1 {
+		t.Error("shared tags should be reported once per pair, got:", n, err)
+	}
+}
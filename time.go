@@ -0,0 +1,68 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+	"time"
+)
+
+// ErrNoTime is returned by Time for versions that do not hold a timestamp
+var ErrNoTime = errors.New("UUID version does not hold a timestamp")
+
+// Time decodes the timestamp of a time based UUID, for debugging and log correlation:
+// 100ns intervals since the Gregorian epoch for v1 and v6 (https://tools.ietf.org/html/rfc4122#section-4.1.4),
+// the same with the low 32 bits replaced by a local ID for v2, so its time is only good to about 7 minutes,
+// and Unix milliseconds for v7 (https://www.rfc-editor.org/rfc/rfc9562#section-5.7).
+// The only error is ErrNoTime, for the other versions. There is no ErrTimestampRange: every value
+// the fields can hold, up to year ~5236 for v1 and v6 and ~10889 for v7, is a time.Time that is
+// decoded exactly, though one past 2262 is outside what its UnixNano can return
+func (u UUID) Time() (time.Time, error) {
+
+	switch u.Version() {
+	case 1:
+		return gregorianTime(v1Timestamp(u)), nil
+	case 2:
+		return gregorianTime(v1Timestamp(u) &^ 0xFFFFFFFF), nil
+	case 6:
+		return gregorianTime(v6Timestamp(u)), nil
+	case 7:
+		return time.UnixMilli(int64(binary.BigEndian.Uint64(u[0:]) >> 16)), nil
+	}
+
+	return time.Time{}, ErrNoTime
+}
+
+// v1Timestamp reads back what insertTimestamp wrote
+func v1Timestamp(u UUID) uint64 {
+	return uint64(binary.BigEndian.Uint16(u[6:])&0x0FFF)<<48 | uint64(binary.BigEndian.Uint16(u[4:]))<<32 | uint64(binary.BigEndian.Uint32(u[0:]))
+}
+
+// v6Timestamp reads back what insertTimestampV6 wrote
+func v6Timestamp(u UUID) uint64 {
+	hi, mid, low := binary.BigEndian.Uint32(u[0:]), binary.BigEndian.Uint16(u[4:]), binary.BigEndian.Uint16(u[6:])&0x0FFF
+	return uint64(hi)<<28 | uint64(mid)<<12 | uint64(low)
+}
+
+// gregorianTime is the inverse of getUUIDEpochTime, without going through UnixNano either
+func gregorianTime(ts uint64) time.Time {
+	sec := int64(ts/10000000) - epochOffset/1000000000
+	return time.Unix(sec, int64(ts%10000000)*100)
+}
+
+// NodeID returns the node field (the last 6 bytes) of u, the MAC address or node ID
+// of the host that made a v1, v2 or v6 UUID (see NodeSource). Other versions have random bits there
+func (u UUID) NodeID() [6]byte {
+	return [6]byte(u[10:])
+}
+
+// ClockSequence returns the 14 bit clock sequence of a v1 or v6 UUID, which together with
+// NodeID tells apart the processes (and restarts) that shared a host. A v2 only has the top
+// 6 bits, the low byte is its Domain
+func (u UUID) ClockSequence() uint16 {
+
+	if u.Version() == 2 {
+		return binary.BigEndian.Uint16(u[8:]) & 0x3F00
+	}
+
+	return binary.BigEndian.Uint16(u[8:]) & 0x3FFF
+}
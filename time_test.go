@@ -0,0 +1,101 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTime(t *testing.T) {
+
+	// test vectors from https://www.rfc-editor.org/rfc/rfc9562#appendix-A
+	want := time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC)
+
+	for _, s := range []string{"c232ab00-9414-11ec-b3c8-9f6bdeced846", "1ec9414c-232a-6b00-b3c8-9f6bdeced846", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"} {
+		uuid, _ := FromString(s)
+		got, err := uuid.Time()
+
+		if err != nil || !got.Equal(want) {
+			t.Error("Time of", s, "should be:", want, "got:", got, err)
+		}
+	}
+
+	now := time.Date(2024, 5, 6, 7, 8, 9, 123456700, time.UTC)
+	g := NewGenerator(WithClock(FixedClock(now)))
+
+	v1, _ := g.NewV1()
+	v6, _ := g.NewV6()
+	v7, _ := g.NewV7()
+
+	for _, test := range []struct {
+		uuid UUID
+		want time.Time
+	}{
+		{v1, now},
+		{v6, now.Add(100)}, // the same tick as the v1, see stale
+		{v7, now.Truncate(time.Millisecond)},
+	} {
+		if got, err := test.uuid.Time(); err != nil || !got.Equal(test.want) {
+			t.Error("Time of", test.uuid.String(), "should be:", test.want, "got:", got, err)
+		}
+	}
+
+	if _, err := NewV4().Time(); err != ErrNoTime {
+		t.Error("Time of a v4 should be:", ErrNoTime, "got:", err)
+	}
+}
+
+func TestNodeIDClockSequence(t *testing.T) {
+
+	node := [6]byte{0x02, 0x42, 0xac, 0x11, 0x00, 0x02}
+	g := NewGenerator(WithNodeID(node))
+
+	for _, gen := range []func() (UUID, error){g.NewV1, g.NewV6} {
+		uuid, _ := gen()
+
+		if uuid.NodeID() != node {
+			t.Error("NodeID should be:", node, "got:", uuid.NodeID())
+		}
+
+		if uuid.ClockSequence() != g.clockSeq&0x3FFF {
+			t.Error("ClockSequence should be:", g.clockSeq&0x3FFF, "got:", uuid.ClockSequence())
+		}
+	}
+
+	uuid, _ := FromString("c232ab00-9414-11ec-b3c8-9f6bdeced846")
+
+	if uuid.ClockSequence() != 0x33C8 || uuid.NodeID() != [6]byte{0x9f, 0x6b, 0xde, 0xce, 0xd8, 0x46} {
+		t.Error("RFC 9562 vector should be 0x33c8 9f6bdeced846, got:", uuid.ClockSequence(), uuid.NodeID())
+	}
+}
+
+func TestTimeRange(t *testing.T) {
+
+	var uuid UUID
+
+	for i := range uuid {
+		uuid[i] = 0xFF
+	}
+
+	v1, v6, v7 := uuid, uuid, uuid
+	v1.version(1)
+	v6.version(6)
+	v7.version(7)
+
+	for _, u := range []UUID{v1, v6} {
+		got, err := u.Time()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ts, err := getUUIDEpochTime(got); err != nil || ts != maxTimestamp {
+			t.Error("Time of the largest timestamp should round trip, got:", got, err)
+		}
+	}
+
+	got, err := v7.Time()
+
+	if err != nil || got.UnixMilli() != maxUnixMs {
+		t.Error("Time of the largest v7 should be:", int64(maxUnixMs), "ms got:", got, err)
+	}
+}
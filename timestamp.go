@@ -1,25 +1,45 @@
 package uuid
 
 import (
-	"math/rand"
-	"os/user"
-	"strconv"
+	"encoding/binary"
+	"errors"
 	"time"
 )
 
 const (
-	epochOffset = 12093408000000000000 // See uuidTime below
+	epochOffset  = 12219292800000000000 // See uuidTime below
+	maxTimestamp = 1<<60 - 1            // the timestamp field is 60 bits, it runs out in year ~5236
 )
 
+// ErrTimestampRange is returned when a time cannot be held by the 60 bit timestamp
+// (before the Gregorian epoch or after year ~5236), or by the 48 bit Unix milliseconds of v7
+var ErrTimestampRange = errors.New("time is out of the UUID timestamp range")
+
 // Timestamp https://tools.ietf.org/html/rfc4122#section-4.1.4 and https://tools.ietf.org/html/rfc4122#section-4.1.2
 // The timestamp is a 60-bit value: so why are we returning 64?
 // The timestamp is a 64 bit value that its last byte is multiplexed with version number (i.e. 1-5)
 type timestamp interface {
-	timestamp() uint64
+	timestamp() (uint64, error)
 }
 
-func getUUIDEpochTime() uint64 {
-	return (uint64(time.Now().UnixNano()) + epochOffset) / 100 // 100 nano second intervals
+// getUUIDEpochTime counts 100 nano second intervals without going through UnixNano,
+// which is undefined outside the years 1678 to 2262
+func getUUIDEpochTime(t time.Time) (uint64, error) {
+
+	sec := t.Unix()
+
+	// coarse bounds first so the math below stays inside int64
+	if sec < -epochOffset/1000000000 || sec > maxTimestamp/10000000 {
+		return 0, ErrTimestampRange
+	}
+
+	ticks := sec*10000000 + int64(t.Nanosecond()/100) + epochOffset/100
+
+	if ticks < 0 || ticks > maxTimestamp {
+		return 0, ErrTimestampRange
+	}
+
+	return uint64(ticks), nil
 }
 
 // V1
@@ -27,47 +47,23 @@ func getUUIDEpochTime() uint64 {
 // as a count of 100-nanosecond intervals since 00:00:00.00, 15 October 1582 (the date of
 // Gregorian reform to the Christian calendar). This is date requires and offset between
 // unix epoch time and and uuid epoch time: thus the epochOffset above (see const)
-type uuidTime struct{}
-
-func (u *uuidTime) timestamp() uint64 {
-	return getUUIDEpochTime()
-}
-
-// V2 is similiar to V1, but with some a couple of differences. First, v2 does not fall under
-// RFC4122. Instead it is defined by DCE1.1 (http://pubs.opengroup.org/onlinepubs/9629399/apdxa.htm)
-// V2 does take a timestamp but the time_low is to be replaced by UID or GID (atm UID is being used)
-type uuidDCE struct{}
-
-func (u *uuidDCE) timestamp() uint64 {
-	t := getUUIDEpochTime()
-	uID := getUser()
-	return (t ^ 0xFFFFFFFF) | uint64(uID)
+type uuidTime struct {
+	now func() time.Time // see Generator.now
 }
 
-//To DO: handle panics
-func getUser() int {
-
-	us, err := user.Current()
-
-	if err != nil {
-		panic(err)
-	}
-
-	i, err := strconv.Atoi(us.Uid)
-
-	if err != nil {
-		panic(err)
-	}
-
-	return i
+func (u *uuidTime) timestamp() (uint64, error) {
+	return getUUIDEpochTime(u.now())
 }
 
 //V4
 // For UUID version 4, the timestamp is a randomly or pseudo-randomly
 // generated 60-bit value, as described in https://tools.ietf.org/html/rfc4122#section-4.4 Section 4.4.
-type uuidRand struct{}
+type uuidRand struct {
+	read func([]byte) // fills a slice with random bytes, see Generator.randomBytes
+}
 
-func (u *uuidRand) timestamp() uint64 {
-	rand.Seed(time.Now().UnixNano())
-	return uint64(rand.Int63())
+func (u *uuidRand) timestamp() (uint64, error) {
+	var b [8]byte
+	u.read(b[:])
+	return binary.BigEndian.Uint64(b[:]), nil
 }
@@ -2,8 +2,47 @@ package uuid
 
 import (
 	"testing"
+	"time"
 )
 
 func TestNamepace(t *testing.T) {
 
 }
+
+func TestTimestampRange(t *testing.T) {
+
+	bad := []time.Time{
+		time.Date(1500, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(6000, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(-300000000, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(300000000, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, test := range bad {
+		if _, err := getUUIDEpochTime(test); err != ErrTimestampRange {
+			t.Error("timestamp of", test, "should be:", ErrTimestampRange, "got:", err)
+		}
+
+		g := NewGenerator(WithClock(FixedClock(test)))
+
+		if _, err := g.NewV1(); err != ErrTimestampRange {
+			t.Error("v1 of", test, "should be:", ErrTimestampRange, "got:", err)
+		}
+	}
+
+	// unix_ts_ms runs out in year ~10889
+	for _, test := range []time.Time{bad[0], time.Date(11000, 1, 1, 0, 0, 0, 0, time.UTC)} {
+		g := NewGenerator(WithClock(FixedClock(test)))
+
+		if _, err := g.NewV7(); err != ErrTimestampRange {
+			t.Error("v7 of", test, "should be:", ErrTimestampRange, "got:", err)
+		}
+	}
+
+	now := time.Now()
+	ts, err := getUUIDEpochTime(now)
+
+	if err != nil || ts != (uint64(now.UnixNano())+epochOffset)/100 {
+		t.Error("timestamp should be:", (uint64(now.UnixNano())+epochOffset)/100, "got:", ts, err)
+	}
+}
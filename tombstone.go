@@ -0,0 +1,50 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// tombstoneMark fills the last 4 bytes of every tombstone, so about 1 in 4 billion other v8
+// UUIDs look like one
+const tombstoneMark = 0x7B5D0E8A
+
+// ErrNotTombstone is returned by FromTombstone for a UUID TombstoneOf did not make
+var ErrNotTombstone = errors.New("UUID is not a tombstone")
+
+// TombstoneOf returns the tombstone of u for soft deletes: a v8 UUID keeping the leading bits of u
+// (the timestamp and clock sequence of time based versions) up to the last 36. Those hold the version
+// of u in one nibble and tombstoneMark in the last 4 bytes, so IsTombstone can tell tombstones from
+// the other v8 UUIDs this package makes and FromTombstone can restore the version.
+// A UUID has no spare bits, so the last 36 bits of u are not kept: check a tombstone against an ID
+// with TombstoneOf(u) == t rather than by reversing it
+func TombstoneOf(u UUID) UUID {
+
+	version := u[6] >> 4
+
+	u.version(8)
+	u[11] = u[11]&0xF0 | version
+	binary.BigEndian.PutUint32(u[12:], tombstoneMark)
+
+	return u
+}
+
+// FromTombstone reverses TombstoneOf as far as the tombstone allows: the version the ID was
+// created as is restored and the last 36 bits, which TombstoneOf did not keep, come back as zero
+func FromTombstone(t UUID) (UUID, error) {
+
+	if !IsTombstone(t) {
+		return Nil, ErrNotTombstone
+	}
+
+	t.version(t[11] & 0x0F)
+	t[11] &= 0xF0
+	clear(t[12:])
+
+	return t, nil
+}
+
+// IsTombstone reports whether t is a tombstone made by TombstoneOf
+func IsTombstone(t UUID) bool {
+	return t.Version() == 8 && t.Variant() == VariantRFC4122 && binary.BigEndian.Uint32(t[12:]) == tombstoneMark
+}
@@ -0,0 +1,95 @@
+package uuid
+
+import (
+	"bytes"
+	"crypto/md5"
+	"strings"
+	"testing"
+)
+
+func TestTombstone(t *testing.T) {
+
+	for _, uuid := range []UUID{NewV4(), NewV7(), NewV1()} {
+		tomb := TombstoneOf(uuid)
+
+		if tomb == uuid || !IsTombstone(tomb) || IsTombstone(uuid) {
+			t.Error("TombstoneOf did not mark the UUID", tomb.String())
+		}
+
+		if _, err := FromBytes(tomb[:]); err != nil {
+			t.Error("tombstone is not a valid UUID", err)
+		}
+
+		back, err := FromTombstone(tomb)
+
+		if err != nil || TombstoneOf(back) != tomb {
+			t.Error("FromTombstone did not recover", uuid.String(), "got:", back.String(), err)
+		}
+
+		want := uuid
+		want[11] &= 0xF0
+		clear(want[12:])
+
+		if back != want {
+			t.Error("FromTombstone should be:", want.String(), "got:", back.String())
+		}
+	}
+}
+
+func TestTombstoneOtherV8(t *testing.T) {
+
+	tenant := NewTenantGenerator(DNSNamespace)
+
+	tests := []UUID{
+		NewV8([16]byte{}),
+		NewV8([16]byte{15: 0xFF}),
+		FromMD5Digest(md5.Sum([]byte("www.example.com"))),
+		tenant.Name("order 1"),
+	}
+
+	fingerprint, err := FingerprintReader(URLNamespace, strings.NewReader("tombstone"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests = append(tests, fingerprint)
+
+	for _, test := range tests {
+		if IsTombstone(test) {
+			t.Error("v8 UUID should not be a tombstone:", test.String())
+		}
+
+		if _, err := FromTombstone(test); err != ErrNotTombstone {
+			t.Error("FromTombstone of", test.String(), "should be:", ErrNotTombstone, "got:", err)
+		}
+	}
+}
+
+func TestTombstoneRandomV8(t *testing.T) {
+
+	tenant := NewTenantGenerator(DNSNamespace)
+
+	gens := map[string]func() UUID{
+		"NewV8": func() UUID {
+			var data [16]byte
+			randomBytes(data[:])
+			return NewV8(data)
+		},
+		"tenant": tenant.New,
+		"fingerprint": func() UUID {
+			var data [32]byte
+			randomBytes(data[:])
+			uuid, _ := FingerprintReader(URLNamespace, bytes.NewReader(data[:]))
+			return uuid
+		},
+	}
+
+	for name, gen := range gens {
+		for i := 0; i < testSize; i++ {
+			if uuid := gen(); IsTombstone(uuid) {
+				t.Fatal(name, "v8 UUID should not be a tombstone:", uuid.String())
+			}
+		}
+	}
+}
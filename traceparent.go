@@ -0,0 +1,62 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"errors"
+)
+
+// traceIDSize is the length of the trace-id field in a W3C traceparent header
+const traceIDSize = 32
+
+var (
+	// ErrTraceID is returned when a trace-id is not 32 lowercase hex characters or is all zeros
+	ErrTraceID = errors.New("trace-id should be 32 lowercase hex characters and not all zeros")
+)
+
+// TraceParentField formats the UUID as the trace-id field of a W3C traceparent header
+// See https://www.w3.org/TR/trace-context/#trace-id
+// The spec forbids an all zero trace-id, so the nil UUID returns an empty string
+func (u UUID) TraceParentField() string {
+
+	if u == (UUID{}) {
+		return ""
+	}
+
+	var dst [traceIDSize]byte
+	EncodeHexLower(&dst, (*[uuidSize]byte)(&u))
+
+	return string(dst[:])
+}
+
+// FromTraceParentField parses a trace-id produced by TraceParentField back into a UUID
+// Uppercase hex is rejected since the spec only allows lowercase. A trace-id is any 16 bytes,
+// so the version and variant bits are not checked
+func FromTraceParentField(s string) (UUID, error) {
+
+	var uuid UUID
+
+	if len(s) != traceIDSize {
+		return uuid, ErrTraceID
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return uuid, ErrTraceID
+		}
+	}
+
+	b, err := hex.DecodeString(s)
+
+	if err != nil {
+		return uuid, err
+	}
+
+	copy(uuid[:], b)
+
+	if uuid == (UUID{}) {
+		return uuid, ErrTraceID
+	}
+
+	return uuid, nil
+}
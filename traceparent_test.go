@@ -0,0 +1,52 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestTraceParentField(t *testing.T) {
+
+	uuid := NewV4()
+	field := uuid.TraceParentField()
+
+	if len(field) != traceIDSize {
+		t.Fatal("TraceParentField wrong length:", field)
+	}
+
+	parsed, err := FromTraceParentField(field)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if parsed != uuid {
+		t.Error("TraceParentField did not round trip", parsed.String(), uuid.String())
+	}
+
+	if (UUID{}).TraceParentField() != "" {
+		t.Error("TraceParentField should be empty for the nil UUID")
+	}
+
+	// an RFC4122 variant with version 0 is not a UUID FromBytes accepts, but is a valid trace-id
+	const example = "4bf92f3577b30da6a3ce929d0e0e4736"
+
+	if parsed, err = FromTraceParentField(example); err != nil || parsed.TraceParentField() != example {
+		t.Error("FromTraceParentField should accept any 16 bytes, got:", parsed.String(), err)
+	}
+}
+
+func TestFromTraceParentFieldBad(t *testing.T) {
+
+	tests := []string{
+		"00000000000000000000000000000000", // all zeros
+		"6BA7B8109DAD11D180B400C04FD430C8", // uppercase
+		"6ba7b8109dad11d180b400c04fd430",   // short
+		"6ba7b810-9dad-11d1-80b4-00c04fd4", // dashes
+	}
+
+	for _, test := range tests {
+		if _, err := FromTraceParentField(test); err != ErrTraceID {
+			t.Error("FromTraceParentField did not reject", test, err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package uuid
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// ulidSize is the length of a ULID, 26 Crockford base32 characters for 128 bits and 2 leading 0 bits
+const ulidSize = 26
+
+// crockfordIndex maps an uppercase Crockford character back to its digit, -1 for the others
+var crockfordIndex = func() (index [256]int8) {
+
+	for i := range index {
+		index[i] = -1
+	}
+
+	for i := 0; i < len(crockfordAlphabet); i++ {
+		index[crockfordAlphabet[i]] = int8(i)
+	}
+
+	return index
+}()
+
+// ToULID re-encodes the 128 bits of u as a ULID (https://github.com/ulid/spec). For a v7 the ULID
+// timestamp is the v7 timestamp, both being 48 bits of Unix milliseconds first, so the ULID sorts
+// and decodes to the same time; the rest of the bits, version and variant included, carry over as
+// randomness. Unlike EncodeBase32 the padding bits come first, as ULIDs have them
+func (u UUID) ToULID() string {
+
+	var dst [ulidSize]byte
+
+	hi, lo := binary.BigEndian.Uint64(u[0:]), binary.BigEndian.Uint64(u[8:])
+
+	for i := ulidSize - 1; i >= 0; i-- {
+		dst[i] = crockfordAlphabet[lo&0x1F]
+		hi, lo = hi>>5, lo>>5|hi<<59
+	}
+
+	return string(dst[:])
+}
+
+// FromULID converts a ULID to a v7 with the same timestamp. A ULID's random part has no version
+// or variant, so 6 of its bits are overwritten with them: the UUID is valid, sorts with other v7s
+// by time, and ToULID gives back the same ULID except for those bits.
+// Like DecodeBase32 it ignores case and reads I and L as 1 and O as 0
+func FromULID(s string) (UUID, error) {
+
+	var uuid UUID
+
+	s = crockfordNormalizer.Replace(strings.ToUpper(s))
+
+	// the first character holds the 2 padding bits and 3 bits of timestamp
+	if len(s) != ulidSize || s[0] > '7' {
+		return uuid, ErrUUIDFormat
+	}
+
+	var hi, lo uint64
+
+	for i := 0; i < len(s); i++ {
+		d := crockfordIndex[s[i]]
+
+		if d < 0 {
+			return uuid, ErrUUIDFormat
+		}
+
+		hi, lo = hi<<5|lo>>59, lo<<5|uint64(d)
+	}
+
+	binary.BigEndian.PutUint64(uuid[0:], hi)
+	binary.BigEndian.PutUint64(uuid[8:], lo)
+
+	uuid.version(7)
+	uuid.variant(rfc4122)
+
+	return uuid, nil
+}
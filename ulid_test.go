@@ -0,0 +1,47 @@
+package uuid
+
+import (
+	"testing"
+	"time"
+)
+
+func TestULID(t *testing.T) {
+
+	// the example ULID of the spec
+	uuid, err := FromULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+
+	if err != nil || uuid.String() != "01563e3a-b5d3-7676-8c61-efb99302bd5b" {
+		t.Error("FromULID should be:", "01563e3a-b5d3-7676-8c61-efb99302bd5b", "got:", uuid, err)
+	}
+
+	if ts, _ := uuid.Time(); ts.UnixMilli() != 1469922850259 {
+		t.Error("ULID time should be:", time.UnixMilli(1469922850259), "got:", ts)
+	}
+
+	// only the 6 version and variant bits change
+	if s := uuid.ToULID(); s != "01ARZ3NDEKESV8RRFFQ69G5FAV" {
+		t.Error("ToULID should be:", "01ARZ3NDEKESV8RRFFQ69G5FAV", "got:", s)
+	}
+
+	for i := 0; i < testSize/100; i++ {
+		v7 := NewV7()
+
+		if back, err := FromULID(v7.ToULID()); err != nil || back != v7 {
+			t.Fatal("a v7 should round trip through a ULID, got:", v7, back, err)
+		}
+	}
+
+	if s := Max.ToULID(); s != "7ZZZZZZZZZZZZZZZZZZZZZZZZZ" {
+		t.Error("Max should be the largest ULID, got:", s)
+	}
+
+	if back, err := FromULID("01arz3ndektsv4rrffq69g5fav"); err != nil || back != uuid {
+		t.Error("FromULID should ignore case, got:", back, err)
+	}
+
+	for _, s := range []string{"", "01ARZ3NDEKTSV4RRFFQ69G5FA", "81ARZ3NDEKTSV4RRFFQ69G5FAV", "01ARZ3NDEKTSV4RRFFQ69G5FAU"} {
+		if _, err := FromULID(s); err != ErrUUIDFormat {
+			t.Error("FromULID should reject", s, "got:", err)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package uuid
+
+import (
+	"errors"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrUUIDUnicode is returned when a UUID string holds non-ASCII characters,
+	// usually full width digits or typographic dashes pasted from a document
+	ErrUUIDUnicode = errors.New("UUID contains non-ASCII characters")
+)
+
+// hasNonASCII reports whether s has any byte outside of ASCII
+func hasNonASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return true
+		}
+	}
+	return false
+}
+
+// mapLookalike maps full width hex digits and dash look alikes to ASCII
+func mapLookalike(r rune) rune {
+	switch {
+	case r >= '０' && r <= '９':
+		return '0' + (r - '０')
+	case r >= 'ａ' && r <= 'ｆ':
+		return 'a' + (r - 'ａ')
+	case r >= 'Ａ' && r <= 'Ｆ':
+		return 'a' + (r - 'Ａ')
+	}
+
+	switch r {
+	case '‐', '‑', '‒', '–', '—', '―', '−', '﹘', '﹣', '－':
+		return '-'
+	}
+
+	return r
+}
+
+// FromStringLenient is FromString for copy and paste: full width hex digits and Unicode dashes
+// are mapped to ASCII first, and uppercase hex is accepted
+func FromStringLenient(s string) (UUID, error) {
+	return parse(strings.Map(mapLookalike, strings.TrimSpace(s)))
+}
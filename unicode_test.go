@@ -0,0 +1,27 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestUnicodeLookalikes(t *testing.T) {
+
+	tests := []string{
+		"６ba7b810-9dad-11d1-80b4-00c04fd430c8", // full width 6
+		"6ba7b810‐9dad‐11d1‐80b4‐00c04fd430c8", // U+2010 hyphen
+		"6ba7b810–9dad–11d1–80b4–00c04fd430c8", // en dash
+		"６ＢＡ７ｂ８１０－９dad-11d1-80b4-00c04fd430c8", // full width mix
+	}
+
+	for _, s := range tests {
+		if _, err := FromString(s); err != ErrUUIDUnicode {
+			t.Error("FromString did not reject look alike", s, err)
+		}
+
+		uuid, err := FromStringLenient(s)
+
+		if err != nil || uuid != DNSNamespace {
+			t.Error("FromStringLenient did not map look alike", s, err)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package uuid
+
+import (
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	urnPrefix = "urn:uuid:"   // https://tools.ietf.org/html/rfc4122#section-3
+	niPrefix  = "ni:///uuid;" // https://tools.ietf.org/html/rfc6920
+)
+
+// URN wraps the UUID in the urn:uuid namespace described in RFC4122 Section 3
+func (u UUID) URN() string {
+	return urnPrefix + u.canonical()
+}
+
+// trimURN strips the urn:uuid: prefix (in any case, as URN namespaces are case insensitive)
+func trimURN(s string) string {
+
+	if len(s) > len(urnPrefix) && strings.EqualFold(s[:len(urnPrefix)], urnPrefix) {
+		return s[len(urnPrefix):]
+	}
+
+	return s
+}
+
+// NI wraps the UUID in a RFC6920 named information URI with uuid as the algorithm
+func (u UUID) NI() string {
+	return niPrefix + u.canonical()
+}
+
+// FormatURI fills a custom URI template with the UUID.
+// {uuid} is replaced with the canonical form and {hex} with the 32 hex digits without dashes
+// e.g. FormatURI("https://example.com/items/{uuid}")
+func (u UUID) FormatURI(tmpl string) string {
+	r := strings.NewReplacer("{uuid}", u.canonical(), "{hex}", hex.EncodeToString(u[:]))
+	return r.Replace(tmpl)
+}
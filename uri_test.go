@@ -0,0 +1,52 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestURIEnvelopes(t *testing.T) {
+
+	s := "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	uuid, err := FromString(s)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		got, want string
+	}{
+		{uuid.URN(), "urn:uuid:" + s},
+		{uuid.NI(), "ni:///uuid;" + s},
+		{uuid.FormatURI("https://example.com/{uuid}"), "https://example.com/" + s},
+		{uuid.FormatURI("id:{hex}"), "id:6ba7b8109dad11d180b400c04fd430c8"},
+		{uuid.FormatURI("no placeholder"), "no placeholder"},
+	}
+
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Error("URI envelope is", test.got, "should be:", test.want)
+		}
+	}
+}
+
+func TestFromStringURN(t *testing.T) {
+
+	for _, s := range []string{"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8", "URN:UUID:6ba7b810-9dad-11d1-80b4-00c04fd430c8"} {
+		uuid, err := FromString(s)
+
+		if err != nil || uuid != NamespaceDNS {
+			t.Error("FromString should accept", s, err)
+		}
+	}
+
+	if uuid, err := FromString(NamespaceURL.URN()); err != nil || uuid != NamespaceURL {
+		t.Error("FromString should read back URN", err)
+	}
+
+	for _, s := range []string{"urn:uuid:", "urn:6ba7b810-9dad-11d1-80b4-00c04fd430c8", "urn:uuid:urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"} {
+		if _, err := FromString(s); err == nil {
+			t.Error("FromString accepted", s)
+		}
+	}
+}
@@ -2,94 +2,61 @@ package uuid
 
 import (
 	"crypto/md5"
+	"crypto/rand"
 	"crypto/sha1"
 	"encoding/binary"
 	"encoding/hex"
 	"errors"
-	"fmt"
-	"math/rand"
+	"io"
 	"net"
-	"regexp"
 	"strings"
-	"sync"
 )
 
 const (
 	uuidSize = 16
 
 	// https://tools.ietf.org/html/rfc4122#section-4.1.1
-	rfc4122 = 0x04
-	future  = 0x07
+	ncs       = 0x00
+	rfc4122   = 0x04
+	microsoft = 0x06
+	future    = 0x07
 )
 
 var (
-	mu         = sync.Mutex{}   // global mutex to prevent races on timeSource and clockSeq
-	timeSource timestamp        // please see timestamp.go for info
-	addr       [6]byte          // hardware address used for v1 and v2
-	clockSeq   = clockSeqInit() // used for v1 and v2
-
-	uuidRegex = regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-[1-5][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+	defaultGenerator = NewGenerator() // used by the package level constructors
 
 	// ErrUUIDSize makes sure byte array is the correct size
 	ErrUUIDSize = errors.New("UUID Size should 16 bytes")
 
-	// ErrUUIDFormat will return if UUID does not pass uuidRegex
+	// ErrUUIDFormat will return if the text is not a UUID, or an RFC4122 variant UUID has an unknown version
 	ErrUUIDFormat = errors.New("UUID is not in the proper format")
 )
 
-func init() {
-	addr = hardwareAddr()
-
-	if err := initNamespace(); err != nil {
-		panic(err)
-	}
-}
-
 // UUID is 128 bits used to create a A Universally Unique IDentifier (UUID) URN Namespace
 // Its specifications are described in RFC4122 and can be found https://tools.ietf.org/html/rfc4122
 type UUID [uuidSize]byte
 
 // NewV1 See https://tools.ietf.org/html/rfc4122#section-4.2.1
 func NewV1() UUID {
-
-	var uuid UUID
-
-	mu.Lock()
-	defer mu.Unlock()
-
-	timeSource = &uuidTime{}
-
-	insertTimestamp(uuid[:], timeSource.timestamp())
-	uuid.version(1)
-
-	clockSeq++
-
-	binary.BigEndian.PutUint16(uuid[8:], clockSeq)
-	uuid.variant(rfc4122) // must set after setting clockSeq
-
-	copy(uuid[10:], addr[:])
-
+	uuid, _ := defaultGenerator.NewV1()
 	return uuid
 }
 
-// NewV2 See http://pubs.opengroup.org/onlinepubs/9629399/apdxa.htm
-func NewV2() UUID {
-
-	var uuid UUID
-
-	mu.Lock()
-	defer mu.Unlock()
-
-	timeSource = &uuidDCE{}
-	insertTimestamp(uuid[:], timeSource.timestamp())
-	uuid.version(2)
-
-	clockSeq++
+// NewV2 See http://pubs.opengroup.org/onlinepubs/9629399/apdxa.htm and Generator.NewV2
+func NewV2(domain Domain, id uint32) UUID {
+	uuid, _ := defaultGenerator.NewV2(domain, id)
+	return uuid
+}
 
-	binary.BigEndian.PutUint16(uuid[8:], clockSeq)
-	uuid.variant(rfc4122) // must set after setting clockSeq
-	copy(uuid[10:], addr[:])
+// NewDCEPerson is NewV2 with the UID of the current user, Nil where there is none
+func NewDCEPerson() UUID {
+	uuid, _ := defaultGenerator.NewDCEPerson()
+	return uuid
+}
 
+// NewDCEGroup is NewV2 with the GID of the current user, Nil where there is none
+func NewDCEGroup() UUID {
+	uuid, _ := defaultGenerator.NewDCEGroup()
 	return uuid
 }
 
@@ -121,19 +88,31 @@ func NewV3(namespace UUID, name string) (UUID, error) {
 
 // NewV4 See https://tools.ietf.org/html/rfc4122#section-4.4
 func NewV4() UUID {
+	uuid, _ := defaultGenerator.NewV4()
+	return uuid
+}
 
-	var uuid UUID
+// NewV6 See https://www.rfc-editor.org/rfc/rfc9562#section-5.6
+func NewV6() UUID {
+	uuid, _ := defaultGenerator.NewV6()
+	return uuid
+}
 
-	mu.Lock()
-	defer mu.Unlock()
+// NewV7 See https://www.rfc-editor.org/rfc/rfc9562#section-5.7
+func NewV7() UUID {
+	uuid, _ := defaultGenerator.NewV7()
+	return uuid
+}
+
+// NewV8 See https://www.rfc-editor.org/rfc/rfc9562#section-5.8
+// data is the caller's own layout (shard IDs, custom timestamps...). The version and variant
+// bits are set over it, which keeps the other 122 bits: 0-47 (custom_a), 52-63 (custom_b) and 66-127 (custom_c)
+func NewV8(data [16]byte) UUID {
 
-	timeSource = &uuidRand{}
-	insertTimestamp(uuid[:], timeSource.timestamp())
-	uuid.version(4)
+	uuid := UUID(data)
 
+	uuid.version(8)
 	uuid.variant(rfc4122)
-	// From Doc: Set all the other bits to randomly (or pseudo-randomly) chosen values
-	randomBytes(uuid[9:])
 
 	return uuid
 }
@@ -164,26 +143,75 @@ func NewV5(namespace UUID, name string) (UUID, error) {
 	return uuid, nil
 }
 
+// Derive returns a deterministic child of parent (a v5 of label with parent as the namespace),
+// so IDs for retries or sub-resources can be recomputed instead of stored
+func Derive(parent UUID, label string) UUID {
+	uuid, _ := NewV5(parent, label) // hash.Hash never returns an error on Write
+	return uuid
+}
+
 // FromString will attempt to convert a uuid hex string into a uuid byte array
-// if string does not pass regex text ErrUUIDFormat will be returned
+// if string is not hex or fails the checks of FromBytes ErrUUIDFormat will be returned
+// With Config.StrictParse only the lowercase 8-4-4-4-12 form is accepted
+// Non-ASCII look alikes return ErrUUIDUnicode, see FromStringLenient to accept them
+// The URN form of RFC4122 Section 3 (urn:uuid:6ba7b810-...), the braced form of Microsoft GUIDs
+// ({6ba7b810-...}) and the 32 hex digits without dashes are accepted as well
+// See ParseStrict and ParseLenient for more and less picky parsing
 func FromString(s string) (UUID, error) {
 
-	var uuid UUID
+	uuid, err := decodeString(s)
 
-	s = strings.Replace(s, "-", "", -1) //remove the dashes as they will cause an error with hex decode
+	if err != nil {
+		return uuid, err
+	}
+
+	if currentConfig().StrictParse && !IsCanonical(trimURN(trimBraces(s))) {
+		return UUID{}, ErrUUIDFormat
+	}
+
+	return FromBytes(uuid[:])
+}
+
+// parse is FromString without the StrictParse check, for the package's own parsers whose
+// input is not meant to be canonical
+func parse(s string) (UUID, error) {
 
-	b, err := hex.DecodeString(s)
+	uuid, err := decodeString(s)
 
 	if err != nil {
 		return uuid, err
 	}
 
-	return FromBytes(b)
+	return FromBytes(uuid[:])
+}
+
+// decodeString reads the text forms FromString accepts without checking the bits
+func decodeString(s string) (UUID, error) {
+
+	var uuid UUID
+
+	if hasNonASCII(s) {
+		return uuid, ErrUUIDUnicode
+	}
 
+	s = trimURN(trimBraces(s))
+	s = strings.Replace(s, "-", "", -1) //remove the dashes as they will cause an error with hex decode
+
+	if len(s) != hex.EncodedLen(uuidSize) {
+		return uuid, ErrUUIDFormat
+	}
+
+	if _, err := hex.Decode(uuid[:], []byte(s)); err != nil {
+		return uuid, err
+	}
+
+	return uuid, nil
 }
 
 // FromBytes will take a in a slice of bytes and attempts to convert into
-// a UUID. If bytes does not pass format or is wrong size and error will be returned
+// a UUID. If bytes is wrong size ErrUUIDSize will be returned
+// The checks are structural: an RFC4122 variant UUID must have a version from 1 to 8,
+// while Nil, Max and the NCS, Microsoft and future variants are accepted as they are
 func FromBytes(b []byte) (UUID, error) {
 
 	var uuid UUID
@@ -194,42 +222,108 @@ func FromBytes(b []byte) (UUID, error) {
 
 	copy(uuid[:], b)
 
-	if !uuidRegex.MatchString(uuid.String()) {
+	if !parseable(uuid) {
 		return uuid, ErrUUIDFormat
 	}
 
 	return uuid, nil
 }
 
-// Format in bytes 4-2-2-2-6
-func (u *UUID) String() string {
-	return fmt.Sprintf("%s-%s-%s-%s-%s", hex.EncodeToString(u[:4]), hex.EncodeToString(u[4:6]), hex.EncodeToString(u[6:8]), hex.EncodeToString(u[8:10]), hex.EncodeToString(u[10:16]))
+// parseable reports whether FromBytes should accept u: anything but an RFC4122 variant
+// with a version outside 1-8
+func parseable(u UUID) bool {
+	return u.Variant() != VariantRFC4122 || knownVersion(u)
+}
+
+// knownVersion reports whether u has a version from 1 to 8
+func knownVersion(u UUID) bool {
+	return u.Version() >= 1 && u.Version() <= 8
+}
+
+// IsCanonical reports whether s is already in the lowercase 8-4-4-4-12 form.
+// It only checks the shape (not version or variant) and does not allocate
+func IsCanonical(s string) bool {
+	return canonicalShape(s, false)
+}
+
+// canonicalShape is IsCanonical, also allowing uppercase hex digits when anyCase is set
+func canonicalShape(s string, anyCase bool) bool {
+
+	if len(s) != 36 {
+		return false
+	}
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch i {
+		case 8, 13, 18, 23:
+			if c != '-' {
+				return false
+			}
+		default:
+			if (c < '0' || c > '9') && (c < 'a' || c > 'f') && (!anyCase || c < 'A' || c > 'F') {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Format in bytes 4-2-2-2-6, in the OutputCase set by Configure
+// The only allocation is the returned string, see AppendText for none
+func (u UUID) String() string {
+
+	var buf [uuidStringSize]byte
+	encodeString(&buf, &u, currentConfig().OutputCase)
+
+	return string(buf[:])
+}
+
+// canonical is the lowercase 4-2-2-2-6 form whatever the Config says
+func (u UUID) canonical() string {
+
+	var buf [uuidStringSize]byte
+	encodeString(&buf, &u, LowerCase)
+
+	return string(buf[:])
+}
+
+// encodeString writes the 4-2-2-2-6 form of u into dst
+func encodeString(dst *[uuidStringSize]byte, u *UUID, c Case) {
+
+	table := hexLower
+
+	if c == UpperCase {
+		table = hexUpper
+	}
+
+	j := 0
+
+	for i, b := range u {
+		switch i {
+		case 4, 6, 8, 10:
+			dst[j] = '-'
+			j++
+		}
+
+		dst[j] = table[b>>4]
+		dst[j+1] = table[b&0x0F]
+		j += 2
+	}
 }
 
 // https://tools.ietf.org/html/rfc4122 (Section: 4.1.3)
 // The version number is in the most significant 4 bits of the time
 // stamp (bits 4 through 7 of the time_hi_and_version field).
 func (u *UUID) version(v byte) {
-	u[6] = (u[6] & 0x0F) | (v << 4)
+	SetVersionBits(u[:], Version(v))
 }
 
 // https://tools.ietf.org/html/rfc4122#section-4.1.1
 func (u *UUID) variant(v byte) {
-	var mask byte
-
-	//0x3F clear top 2
-	//0x1F clear top 3
-
-	switch v {
-	default:
-		mask = 0x3F
-	case rfc4122:
-		mask = 0x3F
-	case future:
-		mask = 0x1F
-	}
-
-	u[8] = (u[8] & mask) | (v << 5)
+	SetVariantBits(u[:], Variant(v))
 }
 
 // Timestamp layout and byte order https://tools.ietf.org/html/rfc4122#section-4.1.2
@@ -239,33 +333,41 @@ func insertTimestamp(b []byte, t uint64) {
 	binary.BigEndian.PutUint16(b[6:], uint16(t>>48))
 }
 
+// Timestamp layout for v6 https://www.rfc-editor.org/rfc/rfc9562#section-5.6
+// time_high (32 bits), time_mid (16 bits) then time_low (12 bits) under the version
+func insertTimestampV6(b []byte, t uint64) {
+	binary.BigEndian.PutUint32(b[0:], uint32(t>>28))
+	binary.BigEndian.PutUint16(b[4:], uint16(t>>12))
+	binary.BigEndian.PutUint16(b[6:], uint16(t&0x0FFF))
+}
+
 // https://tools.ietf.org/html/rfc4122 (Section: 4.1.6)
 // Address attempts to grab a hardware address that is 6 bytes or greater
 // If there is more than one, first one found is ok
-// If one cannot be found the byte array is randomized in accordanize with Section 4.1.6
-func hardwareAddr() [6]byte {
+// All zero addresses (seen on some virtual interfaces) are skipped
+// If one cannot be found false is returned and resolveNode moves down its ladder
+func hardwareAddr() ([6]byte, bool) {
 
 	var addr [6]byte
 	inter, err := net.Interfaces()
 
 	// if there is an error with interfaces
-	// don't panic just randomize
+	// don't panic just let the caller fall back
 	if err != nil {
-		randomBytes(addr[:])
-		return addr
+		return addr, false
 	}
 
 	for _, i := range inter {
 		if len(i.HardwareAddr) > 5 {
 			copy(addr[:], i.HardwareAddr)
-			return addr
+
+			if addr != ([6]byte{}) {
+				return addr, true
+			}
 		}
 	}
 
-	// if we got here no hardware address is set;
-	// randomize it
-	randomBytes(addr[:])
-	return addr
+	return addr, false
 }
 
 // Set the clock to random bytes
@@ -275,9 +377,20 @@ func clockSeqInit() uint16 {
 	return binary.BigEndian.Uint16(b[:])
 }
 
-// See https://golang.org/pkg/math/rand/#Read
+// See https://golang.org/pkg/crypto/rand/#Read
+// or the source set with SetRandSource
 func randomBytes(b []byte) {
-	_, err := rand.Read(b)
+	readRandom(currentConfig().Rand, b)
+}
+
+// readRandom fills b from r, crypto/rand when r is nil
+func readRandom(r io.Reader, b []byte) {
+
+	if r == nil {
+		r = rand.Reader
+	}
+
+	_, err := io.ReadFull(r, b)
 
 	if err != nil {
 		panic(err) // should panic if rand throws and error
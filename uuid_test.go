@@ -1,13 +1,20 @@
 package uuid
 
 import (
+	"bytes"
+	"encoding/binary"
+	"regexp"
 	"testing"
+	"time"
 )
 
 const (
 	testSize = 100000
 )
 
+// uuidRegex is what the constructors of this package must produce
+var uuidRegex = regexp.MustCompile("^[0-9a-f]{8}-[0-9a-f]{4}-[1-8][0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$")
+
 func devNull(i interface{}) {}
 
 func TestInsertTimestamp(t *testing.T) {
@@ -56,10 +63,10 @@ func TestFromStringBadFormat(t *testing.T) {
 		uuid string
 	}{
 		{
-			uuid: "6ba7b814-9dad-61d1-80b4-00c04fd430c8", // wrong version
+			uuid: "6ba7b814-9dad-91d1-80b4-00c04fd430c8", // wrong version
 		},
 		{
-			uuid: "6ba7b814-9dad-11d1-30b4-00c04fd430c8", // wrong variant
+			uuid: "6ba7b814-9dad-11d1-80b4-00c04fd430c", // too short
 		},
 	}
 
@@ -74,6 +81,7 @@ func TestFromStringBadFormat(t *testing.T) {
 
 func TestFromBytesBadFormat(t *testing.T) {
 	b := make([]byte, 16)
+	b[6], b[8] = 0x90, 0x80 // version 9 of the RFC4122 variant
 	_, err := FromBytes(b)
 
 	if err != ErrUUIDFormat {
@@ -105,15 +113,6 @@ func TestRegexV1(t *testing.T) {
 	}
 }
 
-func TestMutexV1(t *testing.T) {
-
-	for i := 0; i < testSize/10; i++ {
-		go func() {
-			NewV1()
-		}()
-	}
-}
-
 func TestCollisionV1(t *testing.T) {
 	uuids := make(map[UUID]uint8)
 
@@ -133,27 +132,19 @@ func TestCollisionV1(t *testing.T) {
 func TestRegexV2(t *testing.T) {
 
 	for i := 0; i < testSize; i++ {
-		uuid := NewV2()
+		uuid := NewV2(DomainPerson, uint32(i))
 		if !uuidRegex.MatchString(uuid.String()) {
 			t.Error("V2 does not pass regex test", uuid.String())
 		}
 	}
 }
 
-func TestMutexV2(t *testing.T) {
-
-	for i := 0; i < testSize/10; i++ {
-		go func() {
-			NewV2()
-		}()
-	}
-}
-
 func TestCollisionV2(t *testing.T) {
 	uuids := make(map[UUID]uint8)
 
+	// a v2 only has 6 bits of clock sequence, enough for 64 with the same ID every 7 minutes
 	for i := 0; i < testSize; i++ {
-		uuid := NewV2()
+		uuid := NewV2(DomainGroup, uint32(i/32))
 
 		_, ok := uuids[uuid]
 
@@ -206,15 +197,6 @@ func TestRegexV4(t *testing.T) {
 	}
 }
 
-func TestMutexV4(t *testing.T) {
-
-	for i := 0; i < testSize/10; i++ {
-		go func() {
-			NewV4()
-		}()
-	}
-}
-
 func TestCollisionV4(t *testing.T) {
 	uuids := make(map[UUID]uint8)
 
@@ -261,6 +243,43 @@ func TestCollisionV5(t *testing.T) {
 
 }
 
+func TestDerive(t *testing.T) {
+
+	parent := NewV4()
+	child := Derive(parent, "retry-1")
+	v5, _ := NewV5(parent, "retry-1")
+
+	if child != v5 {
+		t.Error("Derive is not a v5 of the label", child.String(), v5.String())
+	}
+
+	if Derive(parent, "retry-1") != child {
+		t.Error("Derive is not deterministic")
+	}
+
+	if Derive(parent, "retry-2") == child || Derive(NewV4(), "retry-1") == child {
+		t.Error("Derive collided for different inputs")
+	}
+}
+
+func TestNewV8(t *testing.T) {
+
+	data := [16]byte{}
+	for i := range data {
+		data[i] = 0xFF
+	}
+
+	uuid := NewV8(data)
+
+	if uuid.String() != "ffffffff-ffff-8fff-bfff-ffffffffffff" {
+		t.Error("V8 is", uuid.String())
+	}
+
+	if !uuidRegex.MatchString(uuid.String()) {
+		t.Error("V8 does not pass regex test", uuid.String())
+	}
+}
+
 func TestClockSeqInit(t *testing.T) {
 	var cs uint16
 	var dup int
@@ -279,6 +298,35 @@ func TestClockSeqInit(t *testing.T) {
 	}
 }
 
+func TestIsCanonical(t *testing.T) {
+
+	tests := []struct {
+		s  string
+		ok bool
+	}{
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", true},
+		{"6BA7B810-9DAD-11D1-80B4-00C04FD430C8", false},
+		{"6ba7b8109dad11d180b400c04fd430c8", false},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", false},
+		{"6ba7b810-9dad-11d1-80b4_00c04fd430c8", false},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430cg", false},
+	}
+
+	for _, test := range tests {
+		if IsCanonical(test.s) != test.ok {
+			t.Error("IsCanonical is wrong for", test.s)
+		}
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		IsCanonical(tests[0].s)
+	})
+
+	if allocs != 0 {
+		t.Error("IsCanonical allocates", allocs)
+	}
+}
+
 func BenchmarkV1(b *testing.B) {
 	for n := 0; n < b.N; n++ {
 		uuid := NewV1()
@@ -288,7 +336,7 @@ func BenchmarkV1(b *testing.B) {
 
 func BenchmarkV2(b *testing.B) {
 	for n := 0; n < b.N; n++ {
-		uuid := NewV2()
+		uuid := NewV2(DomainPerson, uint32(n))
 		devNull(uuid)
 	}
 }
@@ -313,3 +361,110 @@ func BenchmarkV5(b *testing.B) {
 		devNull(uuid)
 	}
 }
+
+func TestRegexV6(t *testing.T) {
+
+	for i := 0; i < testSize; i++ {
+		uuid := NewV6()
+
+		if !uuidRegex.MatchString(uuid.String()) {
+			t.Error("V6 does not pass regex test", uuid.String())
+		}
+	}
+}
+
+func TestCollisionV6(t *testing.T) {
+	uuids := make(map[UUID]uint8)
+
+	for i := 0; i < testSize; i++ {
+		uuid := NewV6()
+
+		_, ok := uuids[uuid]
+
+		if ok == true { //collision
+			t.Error("Collision V6:", uuid.String())
+		} else {
+			uuids[uuid] = 0
+		}
+	}
+}
+
+// v6 is the v1 timestamp most significant bits first
+func TestInsertTimestampV6(t *testing.T) {
+
+	var v1, v6 UUID
+	ts := uint64(0x0123456789ABCDEF) & 0x0FFFFFFFFFFFFFFF
+
+	insertTimestamp(v1[:], ts)
+	insertTimestampV6(v6[:], ts)
+	v1.version(1)
+	v6.version(6)
+
+	if v6.canonical()[:18] != "12345678-9abc-6def" {
+		t.Error("V6 timestamp layout is", v6.canonical())
+	}
+
+	if v1.canonical()[:18] != "89abcdef-4567-1123" {
+		t.Error("V1 timestamp layout is", v1.canonical())
+	}
+}
+
+func TestRegexV7(t *testing.T) {
+
+	for i := 0; i < testSize; i++ {
+		uuid := NewV7()
+
+		if !uuidRegex.MatchString(uuid.String()) {
+			t.Error("V7 does not pass regex test", uuid.String())
+		}
+	}
+}
+
+func TestMonotonicV7(t *testing.T) {
+
+	prev := NewV7()
+
+	for i := 0; i < testSize; i++ {
+		uuid := NewV7()
+
+		if bytes.Compare(uuid[:], prev[:]) <= 0 {
+			t.Fatal("V7 is not monotonic", prev.String(), uuid.String())
+		}
+
+		prev = uuid
+	}
+}
+
+func TestCounterOverflowV7(t *testing.T) {
+
+	g := NewGenerator()
+	first, _ := g.NewV7()
+
+	// a millisecond that is already full, or a clock that went back
+	g.counter7 = counter7Max
+	g.lastV7 += 1000
+
+	uuid, _ := g.NewV7()
+
+	if binary.BigEndian.Uint64(uuid[:8])>>16 != uint64(g.lastV7) || g.lastV7 <= time.Now().UnixMilli() {
+		t.Error("V7 did not move the timestamp forward on counter overflow", uuid.String())
+	}
+
+	if bytes.Compare(uuid[:], first[:]) <= 0 {
+		t.Error("V7 went backwards", first.String(), uuid.String())
+	}
+}
+
+func BenchmarkV6(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		uuid := NewV6()
+		devNull(uuid)
+	}
+}
+
+func BenchmarkV7(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		uuid := NewV7()
+		devNull(uuid)
+	}
+}
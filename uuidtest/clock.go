@@ -0,0 +1,46 @@
+// Package uuidtest has helpers for testing code that creates UUIDs with package uuid
+package uuidtest
+
+import (
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/sysoftheworld/uuid"
+)
+
+// SkewedClock returns a uuid.Clock (see uuid.WithClock) that starts at base and runs at wall clock
+// speed, gaining drift for every second that passes (a negative drift loses time).
+// Each reading is also moved by a random amount in [-jitter, jitter]; a jitter larger than the time
+// between two readings makes the clock go backwards, which is how regressions can be tested
+func SkewedClock(base time.Time, jitter, drift time.Duration) uuid.Clock {
+	return &skewedClock{
+		base:   base,
+		start:  time.Now(),
+		jitter: jitter,
+		drift:  drift,
+	}
+}
+
+type skewedClock struct {
+	mu     sync.Mutex // rand.IntN is not safe to share between goroutines
+	base   time.Time
+	start  time.Time
+	jitter time.Duration
+	drift  time.Duration
+}
+
+func (c *skewedClock) Now() time.Time {
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elapsed := time.Since(c.start)
+	t := c.base.Add(elapsed + time.Duration(float64(c.drift)*elapsed.Seconds()))
+
+	if c.jitter > 0 {
+		t = t.Add(time.Duration(rand.Int64N(2*int64(c.jitter)+1)) - c.jitter)
+	}
+
+	return t
+}
@@ -0,0 +1,62 @@
+package uuidtest
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/sysoftheworld/uuid"
+)
+
+const testSize = 10000
+
+func TestSkewedClock(t *testing.T) {
+
+	base := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := SkewedClock(base, 0, 0)
+
+	if now := c.Now(); now.Before(base) || now.Sub(base) > time.Second {
+		t.Error("clock without skew should be close to base:", base, "got:", now)
+	}
+
+	c = SkewedClock(base, time.Second, 0)
+
+	for i := 0; i < testSize; i++ {
+		if now := c.Now(); now.Before(base.Add(-time.Second)) || now.After(base.Add(2*time.Second)) {
+			t.Fatal("jitter should stay inside a second of base, got:", now)
+		}
+	}
+
+	c = SkewedClock(base, 0, time.Hour)
+	time.Sleep(10 * time.Millisecond)
+
+	if c.Now().Sub(base) < 30*time.Second {
+		t.Error("an hour of drift per second should show after 10ms")
+	}
+}
+
+func TestSkewedClockV7(t *testing.T) {
+
+	// a jitter far above the gap between calls makes the clock go backwards all the time
+	g := uuid.NewGenerator(uuid.WithClock(SkewedClock(time.Now(), time.Second, 0)))
+
+	last, err := g.NewV7()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < testSize; i++ {
+		u, err := g.NewV7()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if bytes.Compare(u[:], last[:]) <= 0 {
+			t.Fatal("v7 should keep sorting when the clock goes backwards:", last.String(), u.String())
+		}
+
+		last = u
+	}
+}
@@ -0,0 +1,76 @@
+package uuidtest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sysoftheworld/uuid"
+)
+
+// maxReported caps how many duplicates AssertUnique reports before it only counts them
+const maxReported = 10
+
+// AssertUnique calls gen n times from parallelism goroutines, all released at once so they
+// contend for whatever gen locks, and fails t for every UUID returned more than once.
+// The rate is logged, which shows with go test -v. Run it under go test -race to also have
+// the generator's synchronization checked
+func AssertUnique(t testing.TB, n, parallelism int, gen func() uuid.UUID) {
+
+	t.Helper()
+
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	var wg sync.WaitGroup
+	start := make(chan struct{})
+	results := make([][]uuid.UUID, parallelism)
+
+	for i := range results {
+		share := n / parallelism
+
+		if i < n%parallelism {
+			share++
+		}
+
+		results[i] = make([]uuid.UUID, share)
+		wg.Add(1)
+
+		go func(ids []uuid.UUID) {
+			defer wg.Done()
+			<-start
+
+			for j := range ids {
+				ids[j] = gen()
+			}
+		}(results[i])
+	}
+
+	began := time.Now()
+	close(start)
+	wg.Wait()
+	took := time.Since(began)
+
+	seen := make(map[uuid.UUID]struct{}, n)
+	dups := 0
+
+	for _, ids := range results {
+		for _, id := range ids {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				continue
+			}
+
+			if dups++; dups <= maxReported {
+				t.Errorf("duplicate UUID %s", id)
+			}
+		}
+	}
+
+	if dups > maxReported {
+		t.Errorf("%d duplicate UUIDs in %d", dups, n)
+	}
+
+	t.Logf("%d UUIDs from %d goroutines in %v (%.0f/s)", n, parallelism, took, float64(n)/took.Seconds())
+}
@@ -0,0 +1,64 @@
+package uuidtest
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sysoftheworld/uuid"
+)
+
+// recorder is a testing.TB that keeps the errors instead of failing the test
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Logf(string, ...any) {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertUnique(t *testing.T) {
+
+	var id atomic.Uint32
+
+	// v2 needs a new ID every 64 UUIDs, see uuid.Generator.NewV2
+	v2 := func() uuid.UUID {
+		return uuid.NewV2(uuid.DomainOrg, id.Add(1))
+	}
+
+	for _, gen := range []func() uuid.UUID{uuid.NewV1, v2, uuid.NewV4, uuid.NewV6, uuid.NewV7} {
+		AssertUnique(t, testSize, 16, gen)
+	}
+}
+
+func TestAssertUniqueDuplicates(t *testing.T) {
+
+	var calls atomic.Uint32
+
+	// every UUID comes out twice
+	gen := func() uuid.UUID {
+		var u uuid.UUID
+		u[0] = byte((calls.Add(1) - 1) / 2)
+		return u
+	}
+
+	r := &recorder{TB: t}
+	AssertUnique(r, 8, 3, gen)
+
+	if len(r.errors) != 4 {
+		t.Error("duplicates should be:", 4, "got:", len(r.errors), r.errors)
+	}
+
+	calls.Store(0)
+	r = &recorder{TB: t}
+	AssertUnique(r, 100, 4, gen)
+
+	if len(r.errors) != maxReported+1 {
+		t.Error("reported should be:", maxReported+1, "got:", len(r.errors))
+	}
+}
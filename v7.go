@@ -0,0 +1,89 @@
+package uuid
+
+import (
+	"encoding/binary"
+)
+
+const (
+	counter7Max = 0x0FFF    // rand_a is 12 bits
+	maxUnixMs   = 1<<48 - 1 // unix_ts_ms is 48 bits
+)
+
+// NewV7 See https://www.rfc-editor.org/rfc/rfc9562#section-5.7
+// The first 48 bits are Unix milliseconds so v7 UUIDs sort by creation time.
+// rand_a is used as a counter (Section 6.2, Method 1) so UUIDs from the same millisecond
+// keep sorting in the order they were made: it starts at a random value with its top bit
+// clear and is incremented within the millisecond. If it overflows, or the clock goes
+// backwards, the timestamp is moved forward by a millisecond instead of going back in order
+func (g *Generator) NewV7() (UUID, error) {
+
+	var uuid UUID
+
+	if err := g.allow(1); err != nil {
+		return uuid, err
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := g.now().UnixMilli()
+
+	if ms < 0 || ms > maxUnixMs {
+		return uuid, ErrTimestampRange
+	}
+
+	if g.leases != nil {
+		var err error
+
+		if ms, err = g.leaseMs(ms); err != nil {
+			return uuid, err
+		}
+	}
+
+	if ms > g.lastV7 {
+		g.lastV7 = ms
+		g.counter7 = g.randomCounter7()
+	} else if g.counter7 == counter7Max {
+		g.lastV7++
+		g.counter7 = g.randomCounter7()
+	} else {
+		g.counter7++
+	}
+
+	// the counter overflowed past the end of the epoch
+	if g.leases != nil && g.lastV7 >= g.lease.End {
+		ms, err := g.leaseMs(g.lastV7)
+
+		if err != nil {
+			return uuid, err
+		}
+
+		g.lastV7, g.counter7 = ms, g.randomCounter7()
+	}
+
+	if err := g.saveV7(); err != nil {
+		return uuid, err
+	}
+
+	g.fillV7(&uuid, g.lastV7, g.counter7)
+
+	return g.output(uuid)
+}
+
+// fillV7 lays out ms and counter and adds the random bits. g.mu must be held
+func (g *Generator) fillV7(uuid *UUID, ms int64, counter uint16) {
+
+	binary.BigEndian.PutUint64(uuid[0:], uint64(ms)<<16|uint64(counter))
+	uuid.version(7)
+
+	// From Doc: rand_b the final 62 bits of pseudo-random data
+	g.randomBytes(uuid[8:])
+	uuid.variant(rfc4122)
+}
+
+// randomCounter7 leaves the top bit clear so a millisecond has room for at least 2048 UUIDs
+func (g *Generator) randomCounter7() uint16 {
+	var b [2]byte
+	g.randomBytes(b[:])
+	return binary.BigEndian.Uint16(b[:]) & (counter7Max >> 1)
+}
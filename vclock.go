@@ -0,0 +1,146 @@
+package uuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sort"
+)
+
+// ErrVectorClock is returned by UnmarshalBinary for bytes that are not a VectorClock
+var ErrVectorClock = errors.New("not a vector clock")
+
+// Ordering is the causal relation Compare finds between two VectorClocks
+type Ordering int
+
+const (
+	Equal      Ordering = iota // the same events
+	Before                     // happened before the other clock
+	After                      // happened after the other clock
+	Concurrent                 // neither saw all of the other's events
+)
+
+func (o Ordering) String() string {
+	switch o {
+	case Equal:
+		return "equal"
+	case Before:
+		return "before"
+	case After:
+		return "after"
+	case Concurrent:
+		return "concurrent"
+	}
+	return "unknown"
+}
+
+// VectorClock tracks causality between nodes identified by UUIDs (e.g. a MachineNamespace or
+// a node's v1 UUID). A missing node counts as 0. It encodes to JSON as an object keyed by UUID
+// strings through MarshalText, and to a compact binary form with MarshalBinary
+type VectorClock map[UUID]uint64
+
+// Tick records an event on node and returns its new count
+func (vc VectorClock) Tick(node UUID) uint64 {
+	vc[node]++
+	return vc[node]
+}
+
+// Merge takes in the events of other, keeping the larger count of every node
+func (vc VectorClock) Merge(other VectorClock) {
+	for node, n := range other {
+		if n > vc[node] {
+			vc[node] = n
+		}
+	}
+}
+
+// Copy returns a VectorClock that can be changed without changing vc
+func (vc VectorClock) Copy() VectorClock {
+
+	c := make(VectorClock, len(vc))
+
+	for node, n := range vc {
+		c[node] = n
+	}
+
+	return c
+}
+
+// Compare reports how vc relates to other
+func (vc VectorClock) Compare(other VectorClock) Ordering {
+
+	less, more := false, false
+
+	for node, n := range vc {
+		if n > other[node] {
+			more = true
+		} else if n < other[node] {
+			less = true
+		}
+	}
+
+	for node, n := range other {
+		if _, ok := vc[node]; !ok && n > 0 {
+			less = true
+		}
+	}
+
+	switch {
+	case less && more:
+		return Concurrent
+	case less:
+		return Before
+	case more:
+		return After
+	}
+
+	return Equal
+}
+
+// MarshalBinary writes the nodes sorted by UUID, each as its 16 bytes followed by its count
+// as a uvarint, so equal clocks always encode to equal bytes
+func (vc VectorClock) MarshalBinary() ([]byte, error) {
+
+	nodes := make([]UUID, 0, len(vc))
+
+	for node := range vc {
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return bytes.Compare(nodes[i][:], nodes[j][:]) < 0 })
+
+	b := make([]byte, 0, len(nodes)*(uuidSize+binary.MaxVarintLen64))
+
+	for _, node := range nodes {
+		b = append(b, node[:]...)
+		b = binary.AppendUvarint(b, vc[node])
+	}
+
+	return b, nil
+}
+
+// UnmarshalBinary reads the form written by MarshalBinary, replacing the content of vc
+func (vc *VectorClock) UnmarshalBinary(b []byte) error {
+
+	c := make(VectorClock)
+
+	for len(b) > 0 {
+		if len(b) < uuidSize+1 {
+			return ErrVectorClock
+		}
+
+		node := UUID(b[:uuidSize])
+		n, size := binary.Uvarint(b[uuidSize:])
+
+		if size <= 0 {
+			return ErrVectorClock
+		}
+
+		c[node] = n
+		b = b[uuidSize+size:]
+	}
+
+	*vc = c
+
+	return nil
+}
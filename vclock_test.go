@@ -0,0 +1,68 @@
+package uuid
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVectorClock(t *testing.T) {
+
+	a, b := VectorClock{}, VectorClock{}
+
+	a.Tick(NamespaceDNS)
+	b.Merge(a)
+	b.Tick(NamespaceURL)
+
+	if a.Compare(b) != Before || b.Compare(a) != After || a.Compare(a.Copy()) != Equal {
+		t.Error("a should be before b, got:", a.Compare(b), b.Compare(a))
+	}
+
+	a.Tick(NamespaceDNS)
+
+	if a.Compare(b) != Concurrent || b.Compare(a) != Concurrent {
+		t.Error("a and b should be concurrent, got:", a.Compare(b), b.Compare(a))
+	}
+
+	a.Merge(b)
+
+	if a[NamespaceDNS] != 2 || a[NamespaceURL] != 1 || b.Compare(a) != Before {
+		t.Error("Merge should keep the larger counts, got:", a)
+	}
+
+	if (VectorClock{NamespaceDNS: 0}).Compare(VectorClock{}) != Equal {
+		t.Error("a count of 0 should equal a missing node")
+	}
+}
+
+func TestVectorClockMarshal(t *testing.T) {
+
+	vc := VectorClock{NamespaceURL: 300, NamespaceDNS: 1}
+
+	b, _ := vc.MarshalBinary()
+
+	if len(b) != 2*uuidSize+1+2 || b[0] != NamespaceDNS[0] || b[3] != NamespaceDNS[3] {
+		t.Error("MarshalBinary should sort the nodes, got:", b)
+	}
+
+	var got VectorClock
+
+	if err := got.UnmarshalBinary(b); err != nil || got.Compare(vc) != Equal {
+		t.Error("UnmarshalBinary should read back:", vc, "got:", got, err)
+	}
+
+	if err := got.UnmarshalBinary(b[:20]); err != ErrVectorClock {
+		t.Error("truncated clock should be:", ErrVectorClock, "got:", err)
+	}
+
+	j, err := json.Marshal(vc)
+
+	if err != nil || string(j) != `{"6ba7b810-9dad-11d1-80b4-00c04fd430c8":1,"6ba7b811-9dad-11d1-80b4-00c04fd430c8":300}` {
+		t.Error("JSON is", string(j), err)
+	}
+
+	got = nil
+
+	if err = json.Unmarshal(j, &got); err != nil || got.Compare(vc) != Equal {
+		t.Error("JSON should read back:", vc, "got:", got, err)
+	}
+}
@@ -0,0 +1,84 @@
+package uuid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// verifyPatterns are the edge byte patterns every version/variant class is checked with
+var verifyPatterns = []func(i int) byte{
+	func(i int) byte { return 0x00 },
+	func(i int) byte { return 0xFF },
+	func(i int) byte { return 0x55 },
+	func(i int) byte { return 0xAA },
+	func(i int) byte { return byte(i) },
+	func(i int) byte { return byte(0xFF - i) },
+}
+
+// verifyAccepted is the expected result for each variant, one character per version 0-15:
+// 'y' accepted, '-' rejected. It is written out rather than derived from the parser,
+// so a change to what FromBytes accepts shows up as a failure instead of a new expectation
+var verifyAccepted = map[Variant]string{
+	VariantNCS:       "yyyyyyyyyyyyyyyy",
+	VariantRFC4122:   "-yyyyyyyy-------",
+	VariantMicrosoft: "yyyyyyyyyyyyyyyy",
+	VariantFuture:    "yyyyyyyyyyyyyyyy",
+}
+
+// VerifyRoundTripClasses checks the codec over every version (0-15) and variant combination
+// with edge byte patterns (all zeros, all ones, alternating bits and ramps). For each UUID,
+// FromBytes and FromString of its lowercase, uppercase and dash-less forms must all accept it and
+// give it back unchanged when its version and variant are supported, and all reject it otherwise.
+// With Config.StrictParse the uppercase and dash-less forms must always be rejected.
+// It returns the first failure, so downstream CI can certify a build's codec behaviour
+func VerifyRoundTripClasses() error {
+
+	variants := []Variant{VariantNCS, VariantRFC4122, VariantMicrosoft, VariantFuture}
+	strict := currentConfig().StrictParse
+
+	for v := 0; v < 16; v++ {
+		for _, variant := range variants {
+			for p, pattern := range verifyPatterns {
+
+				var u UUID
+
+				for i := range u {
+					u[i] = pattern(i)
+				}
+
+				SetVersionBits(u[:], Version(v))
+				SetVariantBits(u[:], variant)
+
+				want := verifyAccepted[variant][v] == 'y'
+				s := u.canonical()
+
+				forms := []string{s, strings.ToUpper(s), strings.Replace(s, "-", "", -1)}
+
+				for _, form := range forms {
+					got, err := FromString(form)
+					want := want && (form == s || !strict)
+
+					if want && (err != nil || got != u) {
+						return fmt.Errorf("version %d variant %d pattern %d: %q did not round trip: %v", v, variant, p, form, err)
+					}
+
+					if !want && err == nil {
+						return fmt.Errorf("version %d variant %d pattern %d: %q should be rejected", v, variant, p, form)
+					}
+				}
+
+				got, err := FromBytes(u[:])
+
+				if want && (err != nil || got != u) {
+					return fmt.Errorf("version %d variant %d pattern %d: bytes did not round trip: %v", v, variant, p, err)
+				}
+
+				if !want && err == nil {
+					return fmt.Errorf("version %d variant %d pattern %d: bytes should be rejected", v, variant, p)
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestVerifyRoundTripClasses(t *testing.T) {
+	if err := VerifyRoundTripClasses(); err != nil {
+		t.Error(err)
+	}
+
+	Configure(Config{StrictParse: true})
+	defer Configure(Config{})
+
+	if err := VerifyRoundTripClasses(); err != nil {
+		t.Error("StrictParse:", err)
+	}
+}
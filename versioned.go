@@ -0,0 +1,138 @@
+package uuid
+
+import (
+	"database/sql/driver"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+const versionedIDSize = uuidSize + 1
+
+var (
+	// ErrVersionedIDSize is returned when a VersionedID is not 17 bytes
+	ErrVersionedIDSize = errors.New("VersionedID should be 17 bytes")
+)
+
+// VersionedID pairs a UUID with the schema version of the ID semantics,
+// so an API can change what its IDs mean without breaking stored references
+type VersionedID struct {
+	UUID
+	Schema uint8
+}
+
+// MarshalBinary encodes the ID as the schema byte followed by the 16 UUID bytes
+func (v VersionedID) MarshalBinary() ([]byte, error) {
+	return v.AppendBinary(make([]byte, 0, versionedIDSize))
+}
+
+// AppendBinary appends the form MarshalBinary returns. It also keeps the AppendBinary
+// of the embedded UUID from being used for the whole VersionedID
+func (v VersionedID) AppendBinary(b []byte) ([]byte, error) {
+
+	if err := checkOutput(v.UUID); err != nil {
+		return b, err
+	}
+
+	b = append(b, v.Schema)
+
+	return append(b, v.UUID[:]...), nil
+}
+
+// UnmarshalBinary decodes the form written by MarshalBinary
+func (v *VersionedID) UnmarshalBinary(b []byte) error {
+
+	if len(b) != versionedIDSize {
+		return ErrVersionedIDSize
+	}
+
+	uuid, err := FromBytes(b[1:])
+
+	if err != nil {
+		return err
+	}
+
+	v.Schema = b[0]
+	v.UUID = uuid
+
+	return nil
+}
+
+// MarshalText encodes the binary form as 23 characters of unpadded base64url
+func (v VersionedID) MarshalText() ([]byte, error) {
+	return v.AppendText(nil)
+}
+
+// AppendText appends the form MarshalText returns. Like AppendBinary it shadows the
+// embedded UUID's, which encoding/json would otherwise prefer over MarshalText
+func (v VersionedID) AppendText(text []byte) ([]byte, error) {
+
+	b, err := v.MarshalBinary()
+
+	if err != nil {
+		return text, err
+	}
+
+	return base64.RawURLEncoding.AppendEncode(text, b), nil
+}
+
+// String is the MarshalText form
+func (v VersionedID) String() string {
+	text, _ := v.MarshalText()
+	return string(text)
+}
+
+// Format prints String whatever the verb, in place of the embedded UUID's Format,
+// which would leave out the schema
+func (v VersionedID) Format(f fmt.State, verb rune) {
+	fmt.Fprintf(f, fmt.FormatString(f, verb), v.String())
+}
+
+// UnmarshalText decodes the form written by MarshalText
+func (v *VersionedID) UnmarshalText(text []byte) error {
+
+	b := make([]byte, base64.RawURLEncoding.DecodedLen(len(text)))
+	n, err := base64.RawURLEncoding.Decode(b, text)
+
+	if err != nil {
+		return err
+	}
+
+	return v.UnmarshalBinary(b[:n])
+}
+
+// Scan implements sql.Scanner for the forms Value writes, so the schema survives a round trip
+// through the database rather than going through the embedded UUID's Scan.
+// A 17 byte []byte is the binary form; any other []byte, and a string, is the text form.
+// A NULL leaves the zero VersionedID
+func (v *VersionedID) Scan(src interface{}) error {
+
+	switch src := src.(type) {
+	case nil:
+		*v = VersionedID{}
+		return nil
+	case []byte:
+		if len(src) == versionedIDSize {
+			return v.UnmarshalBinary(src)
+		}
+
+		return v.UnmarshalText(src)
+	case string:
+		return v.UnmarshalText([]byte(src))
+	}
+
+	return ErrScanType
+}
+
+// Value implements driver.Valuer with the MarshalText form, or the MarshalBinary form
+// after PreferBinaryColumns. Either keeps the schema, which the embedded UUID's Value drops
+func (v VersionedID) Value() (driver.Value, error) {
+
+	if currentConfig().BinaryColumns {
+		return v.MarshalBinary()
+	}
+
+	text, err := v.MarshalText()
+
+	return string(text), err
+}
@@ -0,0 +1,92 @@
+package uuid
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+func TestVersionedID(t *testing.T) {
+
+	id := VersionedID{UUID: NewV4(), Schema: 3}
+
+	b, _ := id.MarshalBinary()
+
+	if len(b) != versionedIDSize {
+		t.Fatal("VersionedID binary is", len(b), "bytes")
+	}
+
+	var back VersionedID
+
+	if err := back.UnmarshalBinary(b); err != nil || back != id {
+		t.Error("VersionedID binary did not round trip", err)
+	}
+
+	text, _ := id.MarshalText()
+
+	if len(text) != 23 {
+		t.Error("VersionedID text is", len(text), "characters")
+	}
+
+	if s := fmt.Sprint(id); s != string(text) || id.String() != string(text) {
+		t.Error("VersionedID should print as:", string(text), "got:", s)
+	}
+
+	js, err := json.Marshal(id)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	back = VersionedID{}
+
+	if err = json.Unmarshal(js, &back); err != nil || back != id {
+		t.Error("VersionedID json did not round trip", string(js), err)
+	}
+
+	if err = back.UnmarshalBinary(b[:10]); err != ErrVersionedIDSize {
+		t.Error("VersionedID did not detect wrong length")
+	}
+}
+
+var (
+	_ sql.Scanner   = (*VersionedID)(nil)
+	_ driver.Valuer = VersionedID{}
+)
+
+func TestVersionedIDSQL(t *testing.T) {
+
+	defer Configure(Config{})
+
+	id := VersionedID{UUID: NewV4(), Schema: 7}
+
+	for _, binary := range []bool{false, true} {
+		if binary {
+			PreferBinaryColumns()
+		}
+
+		v, err := id.Value()
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var back VersionedID
+
+		if err = back.Scan(v); err != nil || back != id {
+			t.Error("VersionedID did not round trip through", v, "got:", back.Schema, back.UUID.String(), err)
+		}
+	}
+
+	id = VersionedID{UUID: NewV4(), Schema: 1}
+
+	if err := id.Scan(nil); err != nil || id != (VersionedID{}) {
+		t.Error("Scan of NULL should be the zero VersionedID, got:", id, err)
+	}
+
+	if err := id.Scan(42); err != ErrScanType {
+		t.Error("Scan of an int should be:", ErrScanType, "got:", err)
+	}
+}
@@ -0,0 +1,17 @@
+package uuid
+
+import (
+	"runtime"
+)
+
+// Zero wipes u to Nil, for UUIDs used as one time tokens that should not linger in memory dumps.
+// runtime.KeepAlive keeps the compiler from dropping the writes as dead stores once u is unused.
+// Copies of u made before (including the ones String and the codecs made) are not wiped
+func (u *UUID) Zero() {
+
+	for i := range u {
+		u[i] = 0
+	}
+
+	runtime.KeepAlive(u)
+}
@@ -0,0 +1,15 @@
+package uuid
+
+import (
+	"testing"
+)
+
+func TestZero(t *testing.T) {
+
+	uuid := NewV4()
+	uuid.Zero()
+
+	if !uuid.IsNil() {
+		t.Error("Zero should leave Nil, got:", uuid.String())
+	}
+}